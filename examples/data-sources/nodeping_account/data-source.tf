@@ -0,0 +1,10 @@
+# Fetch the account's plan, limits and usage
+data "nodeping_account" "current" {}
+
+output "plan" {
+  value = data.nodeping_account.current.plan
+}
+
+output "checks_left" {
+  value = data.nodeping_account.current.check_limit - data.nodeping_account.current.check_count
+}
@@ -3,6 +3,11 @@ data "nodeping_check" "example" {
   id = "201205050153W2Q4C-0J2HSIRF"
 }
 
+# Fetch a single check by label
+data "nodeping_check" "api" {
+  label = "api.example.com"
+}
+
 output "check_label" {
   value = data.nodeping_check.example.label
 }
@@ -15,3 +20,7 @@ output "check_state" {
 output "check_enabled" {
   value = data.nodeping_check.example.enabled
 }
+
+output "api_check_id" {
+  value = data.nodeping_check.api.id
+}
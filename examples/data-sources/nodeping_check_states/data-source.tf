@@ -0,0 +1,5 @@
+# Fail the plan while any dependency of this service is down
+data "nodeping_check_states" "dependencies" {
+  tags             = ["payments-dependency"]
+  error_if_failing = true
+}
@@ -6,6 +6,21 @@ data "nodeping_checks" "http_only" {
   type = "HTTP"
 }
 
+# Fetch enabled production checks that run from North America
+data "nodeping_checks" "prod_nam" {
+  tags        = ["prod"]
+  enabled     = true
+  runlocation = "nam"
+}
+
+# Fetch checks with a generic filter
+data "nodeping_checks" "web" {
+  filter {
+    name   = "label_regex"
+    values = ["\\.example\\.com$"]
+  }
+}
+
 output "all_check_ids" {
   value = [for c in data.nodeping_checks.all.checks : c.id]
 }
@@ -17,3 +32,7 @@ output "http_check_labels" {
 output "failing_checks" {
   value = [for c in data.nodeping_checks.all.checks : c.label if c.state == 0]
 }
+
+output "www_check_id" {
+  value = data.nodeping_checks.web.checks_by_label["www.example.com"].id
+}
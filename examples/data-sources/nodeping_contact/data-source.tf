@@ -3,6 +3,11 @@ data "nodeping_contact" "example" {
   id = "201205050153W2Q4C-BKPGH"
 }
 
+# Fetch a single contact by name
+data "nodeping_contact" "devops" {
+  name = "DevOps Team"
+}
+
 output "contact_name" {
   value = data.nodeping_contact.example.name
 }
@@ -0,0 +1,16 @@
+# Resolve the address ID of a contact's SMS address
+data "nodeping_contact_address" "oncall_sms" {
+  contact_name = "On-Call"
+  type         = "sms"
+}
+
+resource "nodeping_check" "website" {
+  type   = "HTTP"
+  target = "https://example.com"
+
+  notifications {
+    contact_id = data.nodeping_contact_address.oncall_sms.id
+    delay      = 0
+    schedule   = "All"
+  }
+}
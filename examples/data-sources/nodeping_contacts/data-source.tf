@@ -1,6 +1,11 @@
 # Fetch all contacts
 data "nodeping_contacts" "all" {}
 
+# Fetch contacts with a webhook address
+data "nodeping_contacts" "webhooks" {
+  address_type = "webhook"
+}
+
 output "all_contact_ids" {
   value = [for c in data.nodeping_contacts.all.contacts : c.id]
 }
@@ -0,0 +1,10 @@
+# Trace the route to a check's target from North America
+data "nodeping_diagnostic" "mtr" {
+  check_id = "201205050153W2Q4C-0J2HSIRF"
+  tool     = "mtr"
+  location = "nam"
+}
+
+output "mtr" {
+  value = data.nodeping_diagnostic.mtr.output
+}
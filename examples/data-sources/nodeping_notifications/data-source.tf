@@ -0,0 +1,12 @@
+# Fetch the notifications sent for a check over the last two days
+data "nodeping_notifications" "incident" {
+  check_id = "201205050153W2Q4C-0J2HSIRF"
+  span     = 48
+}
+
+output "paged" {
+  value = [
+    for n in data.nodeping_notifications.incident.notifications :
+    "${n.timestamp} ${n.type} via ${n.method}: ${n.status}"
+  ]
+}
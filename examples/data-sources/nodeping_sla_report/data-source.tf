@@ -0,0 +1,10 @@
+# Quarterly SLA report for the production checks
+data "nodeping_sla_report" "production" {
+  tag     = "production"
+  quarter = "2026-Q3"
+  target  = 99.9
+}
+
+output "sla_breaches" {
+  value = data.nodeping_sla_report.production.breached_check_ids
+}
@@ -0,0 +1,6 @@
+# List checks created outside Terraform. Requires managed_tag on the provider.
+data "nodeping_unmanaged_checks" "all" {}
+
+output "unmanaged_import_ids" {
+  value = data.nodeping_unmanaged_checks.all.import_ids
+}
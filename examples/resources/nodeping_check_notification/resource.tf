@@ -0,0 +1,21 @@
+# Check whose notifications are attached separately
+resource "nodeping_check" "api" {
+  type                 = "HTTP"
+  target               = "https://api.example.com/health"
+  label                = "API Health"
+  manage_notifications = false
+}
+
+# Page the on-call contact immediately
+resource "nodeping_check_notification" "oncall" {
+  check_id   = nodeping_check.api.id
+  contact_id = "K5SP9CQP"
+}
+
+# Escalate to a contact group after 15 minutes
+resource "nodeping_check_notification" "escalation" {
+  check_id   = nodeping_check.api.id
+  contact_id = "201205050153W2Q4C-G-3QJWG"
+  delay      = 15
+  schedule   = "All"
+}
@@ -0,0 +1,24 @@
+# Shared contact; its addresses are owned by separate resources
+resource "nodeping_contact" "oncall" {
+  name             = "On-Call"
+  custrole         = "notify"
+  manage_addresses = false
+}
+
+# SMS address owned by the NOC
+resource "nodeping_contact_address" "noc_sms" {
+  contact_id = nodeping_contact.oncall.id
+  type       = "sms"
+  address    = "+1-555-123-4567"
+}
+
+# Webhook address owned by the application team
+resource "nodeping_contact_address" "app_webhook" {
+  contact_id = nodeping_contact.oncall.id
+  type       = "webhook"
+  address    = "https://hooks.example.com/nodeping"
+  action     = "post"
+  headers = {
+    "Content-Type" = "application/json"
+  }
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// AccountInfo describes the plan of an account, its limits and how much of
+// them is in use. A zero limit means the plan sets none.
+type AccountInfo struct {
+	ID     string        `json:"_id"`
+	Name   string        `json:"name"`
+	Plan   string        `json:"plan"`
+	Status string        `json:"status"`
+	Limits AccountLimits `json:"limits"`
+	Counts AccountCounts `json:"counts"`
+}
+
+type AccountLimits struct {
+	Checks      int     `json:"checks"`
+	Contacts    int     `json:"contacts"`
+	MinInterval float64 `json:"mininterval"`
+}
+
+type AccountCounts struct {
+	Checks   int `json:"checks"`
+	Contacts int `json:"contacts"`
+}
+
+// GetAccountInfo returns the plan, limits and usage of the client's
+// account.
+func (c *Client) GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
+	var result AccountInfo
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/accounts/info",
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	return &result, nil
+}
+
+// accountCache keeps one AccountInfo per account, plus the checks planned
+// for creation in this run, so quota checks at plan time cost a single
+// request per account.
+type accountCache struct {
+	mu      sync.Mutex
+	info    map[string]*AccountInfo
+	planned map[string]int
+}
+
+func newAccountCache() *accountCache {
+	return &accountCache{
+		info:    make(map[string]*AccountInfo),
+		planned: make(map[string]int),
+	}
+}
+
+// CachedAccountInfo returns the account info of the client's account,
+// fetched once and shared by every client derived from the same provider
+// configuration.
+func (c *Client) CachedAccountInfo(ctx context.Context) (*AccountInfo, error) {
+	c.accounts.mu.Lock()
+	defer c.accounts.mu.Unlock()
+
+	if info, ok := c.accounts.info[c.customerID]; ok {
+		return info, nil
+	}
+
+	info, err := c.GetAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.accounts.info[c.customerID] = info
+	return info, nil
+}
+
+// PlanCheckCreate records a check planned for creation in the client's
+// account and returns how many checks are planned so far. Each call is
+// counted: the framework does not tell a resource its address, and
+// instances created with count or for_each can share every attribute, so
+// nothing identifies a resource planned twice. Terraform can plan the same
+// instance more than once in a provider process, for example when a plan
+// is followed by its apply, so the count is an upper bound.
+func (c *Client) PlanCheckCreate() int {
+	c.accounts.mu.Lock()
+	defer c.accounts.mu.Unlock()
+
+	c.accounts.planned[c.customerID]++
+	return c.accounts.planned[c.customerID]
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCachedAccountInfo(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/accounts/info" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"_id":"201205050153W2Q4C","name":"Example","plan":"Starter","status":"Active","limits":{"checks":30,"contacts":10,"mininterval":1},"counts":{"checks":28,"contacts":4}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	for i := 0; i < 2; i++ {
+		info, err := c.CachedAccountInfo(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.Limits.Checks != 30 || info.Counts.Checks != 28 || info.Limits.MinInterval != 1 {
+			t.Errorf("unexpected account info: %+v", info)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestPlanCheckCreate(t *testing.T) {
+	c := NewClient(ClientConfig{APIToken: "test-token"})
+
+	if n := c.PlanCheckCreate(); n != 1 {
+		t.Errorf("expected 1 planned, got %d", n)
+	}
+	if n := c.PlanCheckCreate(); n != 2 {
+		t.Errorf("expected 2 planned, got %d", n)
+	}
+	if n := c.WithCustomerID("other").PlanCheckCreate(); n != 1 {
+		t.Errorf("expected separate count per account, got %d", n)
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import "fmt"
+
+// CheckNotification is one entry of a check's notification list.
+type CheckNotification struct {
+	ContactID string
+	Delay     int64
+	Schedule  string
+}
+
+// RunLocationList returns the check's run locations. The API reports false
+// when none are set.
+func (c *Check) RunLocationList() []string {
+	switch rl := c.RunLocations.(type) {
+	case []interface{}:
+		locations := make([]string, 0, len(rl))
+		for _, loc := range rl {
+			if s, ok := loc.(string); ok {
+				locations = append(locations, s)
+			}
+		}
+		return locations
+	case []string:
+		return rl
+	}
+	return nil
+}
+
+// DepID returns the ID of the check this check depends on, or "" when it
+// has none. The API reports false when unset.
+func (c *Check) DepID() string {
+	if dep, ok := c.Dep.(string); ok {
+		return dep
+	}
+	return ""
+}
+
+// NotificationList returns the check's notifications in API order,
+// dropping duplicates. A missing schedule means "All".
+func (c *Check) NotificationList() []CheckNotification {
+	var notifications []CheckNotification
+	seen := make(map[string]bool)
+	for _, n := range c.Notifications {
+		for contactID, config := range n {
+			configMap, ok := config.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			notification := CheckNotification{ContactID: contactID, Schedule: "All"}
+			if d, ok := configMap["delay"].(float64); ok {
+				notification.Delay = int64(d)
+			}
+			if s, ok := configMap["schedule"].(string); ok {
+				notification.Schedule = s
+			}
+
+			key := fmt.Sprintf("%s:%d:%s", notification.ContactID, notification.Delay, notification.Schedule)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			notifications = append(notifications, notification)
+		}
+	}
+	return notifications
+}
+
+// ThresholdValue returns the check's timeout in seconds, and whether the
+// API reported one.
+func (c *Check) ThresholdValue() (int64, bool) {
+	return parameterInt(c.Parameters.Threshold)
+}
+
+// SensValue returns the check's sensitivity, and whether the API reported
+// one.
+func (c *Check) SensValue() (int64, bool) {
+	return parameterInt(c.Parameters.Sens)
+}
+
+// FirstDownTime returns when the check's current failure began, in
+// milliseconds since the epoch, and whether it is failing.
+func (c *Check) FirstDownTime() (int64, bool) {
+	ms, ok := parameterInt(c.FirstDown)
+	return ms, ok && ms > 0
+}
+
+// parameterInt reads a numeric parameter the API may send as a number or
+// a string.
+func parameterInt(v interface{}) (int64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return int64(val), true
+	case string:
+		var i int64
+		if _, err := fmt.Sscanf(val, "%d", &i); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCheckFieldHelpers(t *testing.T) {
+	var check Check
+	err := json.Unmarshal([]byte(`{
+		"_id": "201205050153W2Q4C-0J2HSIRF",
+		"firstdown": 1431969400000,
+		"runlocations": ["nam", "eur"],
+		"dep": "201205050153W2Q4C-4RZT8MLN",
+		"notifications": [
+			{"201205050153W2Q4C-BKPGH-K5SP9CQP": {"delay": 5, "schedule": "Weekdays"}},
+			{"201205050153W2Q4C-BKPGH-K5SP9CQP": {"delay": 5, "schedule": "Weekdays"}},
+			{"201205050153W2Q4C-G-3BKRS": {"delay": 0}}
+		],
+		"parameters": {"target": "https://example.com", "threshold": 10, "sens": "3"}
+	}`), &check)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := check.RunLocationList(); len(got) != 2 || got[0] != "nam" || got[1] != "eur" {
+		t.Errorf("unexpected run locations: %v", got)
+	}
+	if got := check.DepID(); got != "201205050153W2Q4C-4RZT8MLN" {
+		t.Errorf("unexpected dep: %q", got)
+	}
+
+	notifications := check.NotificationList()
+	if len(notifications) != 2 {
+		t.Fatalf("expected 2 notifications, got %d: %v", len(notifications), notifications)
+	}
+	if notifications[0].Delay != 5 || notifications[0].Schedule != "Weekdays" {
+		t.Errorf("unexpected first notification: %+v", notifications[0])
+	}
+	if notifications[1].Schedule != "All" {
+		t.Errorf("expected default schedule All, got %q", notifications[1].Schedule)
+	}
+
+	if threshold, ok := check.ThresholdValue(); !ok || threshold != 10 {
+		t.Errorf("unexpected threshold: %d %v", threshold, ok)
+	}
+	if sens, ok := check.SensValue(); !ok || sens != 3 {
+		t.Errorf("unexpected sens: %d %v", sens, ok)
+	}
+	if firstDown, ok := check.FirstDownTime(); !ok || firstDown != 1431969400000 {
+		t.Errorf("unexpected first down: %d %v", firstDown, ok)
+	}
+}
+
+func TestCheckFieldHelpersUnset(t *testing.T) {
+	var check Check
+	if err := json.Unmarshal([]byte(`{"runlocations": false, "dep": false}`), &check); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := check.RunLocationList(); got != nil {
+		t.Errorf("expected no run locations, got %v", got)
+	}
+	if got := check.DepID(); got != "" {
+		t.Errorf("expected no dep, got %q", got)
+	}
+	if _, ok := check.ThresholdValue(); ok {
+		t.Error("expected no threshold")
+	}
+	if _, ok := check.FirstDownTime(); ok {
+		t.Error("expected no first down")
+	}
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Check filter names accepted by NewCheckFilter.
+const (
+	CheckFilterID             = "id"
+	CheckFilterType           = "type"
+	CheckFilterLabel          = "label"
+	CheckFilterLabelRegex     = "label_regex"
+	CheckFilterTarget         = "target"
+	CheckFilterTargetContains = "target_contains"
+	CheckFilterEnabled        = "enabled"
+	CheckFilterState          = "state"
+	CheckFilterTag            = "tag"
+	CheckFilterRunLocation    = "runlocation"
+	CheckFilterCustomerID     = "customer_id"
+	CheckFilterDep            = "dep"
+)
+
+// CheckFilterNames lists the names a CheckFilterTerm may use.
+var CheckFilterNames = []string{
+	CheckFilterID,
+	CheckFilterType,
+	CheckFilterLabel,
+	CheckFilterLabelRegex,
+	CheckFilterTarget,
+	CheckFilterTargetContains,
+	CheckFilterEnabled,
+	CheckFilterState,
+	CheckFilterTag,
+	CheckFilterRunLocation,
+	CheckFilterCustomerID,
+	CheckFilterDep,
+}
+
+// CheckFilterTerm matches checks whose Name field matches any of Values.
+type CheckFilterTerm struct {
+	Name   string
+	Values []string
+}
+
+// CheckFilter selects checks matching every one of its terms.
+type CheckFilter struct {
+	terms   []CheckFilterTerm
+	regexes map[int][]*regexp.Regexp
+}
+
+// NewCheckFilter validates terms and compiles their regular expressions.
+func NewCheckFilter(terms []CheckFilterTerm) (*CheckFilter, error) {
+	f := &CheckFilter{regexes: make(map[int][]*regexp.Regexp)}
+	for i, term := range terms {
+		if !containsString(CheckFilterNames, term.Name) {
+			return nil, fmt.Errorf("unknown filter name %q, expected one of: %s", term.Name, strings.Join(CheckFilterNames, ", "))
+		}
+		if len(term.Values) == 0 {
+			return nil, fmt.Errorf("filter %q has no values", term.Name)
+		}
+		values := make([]string, len(term.Values))
+		for j, v := range term.Values {
+			values[j] = v
+			switch term.Name {
+			case CheckFilterLabelRegex:
+				re, err := regexp.Compile(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s %q: %w", term.Name, v, err)
+				}
+				f.regexes[i] = append(f.regexes[i], re)
+			case CheckFilterEnabled:
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s value %q, expected true or false", term.Name, v)
+				}
+				values[j] = strconv.FormatBool(b)
+			case CheckFilterState:
+				n, err := strconv.Atoi(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s value %q, expected 0 (failing) or 1 (passing)", term.Name, v)
+				}
+				values[j] = strconv.Itoa(n)
+			}
+		}
+		f.terms = append(f.terms, CheckFilterTerm{Name: term.Name, Values: values})
+	}
+	return f, nil
+}
+
+// Match reports whether check satisfies every term of the filter.
+func (f *CheckFilter) Match(check *Check) bool {
+	for i, term := range f.terms {
+		if !f.matchTerm(i, term, check) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns the checks matching the filter, sorted by ID so results
+// are stable between reads.
+func (f *CheckFilter) Apply(checks map[string]Check) []Check {
+	matched := make([]Check, 0, len(checks))
+	for _, check := range checks {
+		if f.Match(&check) {
+			matched = append(matched, check)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	return matched
+}
+
+// SelectChecks picks checks out of all, as listed by ListChecks: those
+// carrying any of tags when tags is not empty, otherwise those named by ids.
+// The checks are sorted by ID. IDs missing from all are returned
+// separately, in the order given; duplicate IDs are selected once.
+func SelectChecks(all map[string]Check, ids, tags []string) ([]Check, []string, error) {
+	if len(tags) > 0 {
+		filter, err := NewCheckFilter([]CheckFilterTerm{
+			{Name: CheckFilterTag, Values: tags},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return filter.Apply(all), nil, nil
+	}
+
+	seen := make(map[string]bool, len(ids))
+	var checks []Check
+	var missing []string
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		check, ok := all[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		checks = append(checks, check)
+	}
+
+	sort.Slice(checks, func(i, j int) bool { return checks[i].ID < checks[j].ID })
+	return checks, missing, nil
+}
+
+func (f *CheckFilter) matchTerm(i int, term CheckFilterTerm, check *Check) bool {
+	switch term.Name {
+	case CheckFilterLabelRegex:
+		for _, re := range f.regexes[i] {
+			if re.MatchString(check.Label) {
+				return true
+			}
+		}
+		return false
+	case CheckFilterTag:
+		return anyString(term.Values, check.Tags)
+	case CheckFilterRunLocation:
+		return anyString(term.Values, check.RunLocationList())
+	}
+
+	var field string
+	switch term.Name {
+	case CheckFilterID:
+		field = check.ID
+	case CheckFilterType:
+		field = check.Type
+	case CheckFilterLabel:
+		field = check.Label
+	case CheckFilterTarget, CheckFilterTargetContains:
+		field = check.Parameters.Target
+	case CheckFilterEnabled:
+		field = strconv.FormatBool(check.Enabled == "active")
+	case CheckFilterState:
+		field = strconv.Itoa(check.State)
+	case CheckFilterCustomerID:
+		field = check.CustomerID
+	case CheckFilterDep:
+		field = check.DepID()
+	}
+
+	for _, v := range term.Values {
+		if term.Name == CheckFilterTargetContains {
+			if strings.Contains(field, v) {
+				return true
+			}
+		} else if v == field {
+			return true
+		}
+	}
+	return false
+}
+
+// anyString reports whether values and list share an element.
+func anyString(values, list []string) bool {
+	for _, v := range values {
+		if containsString(list, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testFilterChecks(t *testing.T) map[string]Check {
+	t.Helper()
+
+	var checks map[string]Check
+	err := json.Unmarshal([]byte(`{
+		"C-2": {"_id": "C-2", "customer_id": "A", "type": "HTTP", "label": "api.example.com", "enable": "active", "state": 1,
+			"tags": ["prod", "api"], "runlocations": ["nam"], "parameters": {"target": "https://api.example.com/health"}},
+		"C-1": {"_id": "C-1", "customer_id": "A", "type": "PING", "label": "db1", "enable": "inactive", "state": 0,
+			"tags": ["prod"], "runlocations": false, "dep": "C-2", "parameters": {"target": "10.0.0.1"}},
+		"C-3": {"_id": "C-3", "customer_id": "B", "type": "HTTP", "label": "www.example.com", "enable": "active", "state": 0,
+			"tags": ["staging", "api"], "runlocations": ["eur", "nam"], "parameters": {"target": "https://www.example.com"}}
+	}`), &checks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return checks
+}
+
+func TestCheckFilter(t *testing.T) {
+	checks := testFilterChecks(t)
+
+	tests := []struct {
+		name  string
+		terms []CheckFilterTerm
+		want  []string
+	}{
+		{"no terms", nil, []string{"C-1", "C-2", "C-3"}},
+		{"type", []CheckFilterTerm{{Name: "type", Values: []string{"HTTP"}}}, []string{"C-2", "C-3"}},
+		{"values are ORed", []CheckFilterTerm{{Name: "id", Values: []string{"C-1", "C-3"}}}, []string{"C-1", "C-3"}},
+		{"terms are ANDed", []CheckFilterTerm{
+			{Name: "tag", Values: []string{"prod"}},
+			{Name: "tag", Values: []string{"api"}},
+		}, []string{"C-2"}},
+		{"label regex", []CheckFilterTerm{{Name: "label_regex", Values: []string{`\.example\.com$`}}}, []string{"C-2", "C-3"}},
+		{"target contains", []CheckFilterTerm{{Name: "target_contains", Values: []string{"/health"}}}, []string{"C-2"}},
+		{"enabled", []CheckFilterTerm{{Name: "enabled", Values: []string{"False"}}}, []string{"C-1"}},
+		{"state", []CheckFilterTerm{{Name: "state", Values: []string{"0"}}}, []string{"C-1", "C-3"}},
+		{"runlocation", []CheckFilterTerm{{Name: "runlocation", Values: []string{"eur"}}}, []string{"C-3"}},
+		{"customer_id", []CheckFilterTerm{{Name: "customer_id", Values: []string{"B"}}}, []string{"C-3"}},
+		{"dep", []CheckFilterTerm{{Name: "dep", Values: []string{"C-2"}}}, []string{"C-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewCheckFilter(tt.terms)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			matched := f.Apply(checks)
+			if len(matched) != len(tt.want) {
+				t.Fatalf("expected %v, got %d checks", tt.want, len(matched))
+			}
+			for i, check := range matched {
+				if check.ID != tt.want[i] {
+					t.Errorf("expected %v, got check %s at %d", tt.want, check.ID, i)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectChecks(t *testing.T) {
+	checks := testFilterChecks(t)
+
+	selected, missing, err := SelectChecks(checks, []string{"C-3", "C-9", "C-1", "C-3"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 2 || selected[0].ID != "C-1" || selected[1].ID != "C-3" {
+		t.Errorf("expected C-1 and C-3, got %v", selected)
+	}
+	if len(missing) != 1 || missing[0] != "C-9" {
+		t.Errorf("expected C-9 to be missing, got %v", missing)
+	}
+
+	selected, missing, err = SelectChecks(checks, nil, []string{"staging", "api"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 2 || selected[0].ID != "C-2" || selected[1].ID != "C-3" {
+		t.Errorf("expected C-2 and C-3, got %v", selected)
+	}
+	if missing != nil {
+		t.Errorf("expected nothing missing for tags, got %v", missing)
+	}
+}
+
+func TestNewCheckFilterInvalid(t *testing.T) {
+	tests := []CheckFilterTerm{
+		{Name: "colour", Values: []string{"red"}},
+		{Name: "type", Values: nil},
+		{Name: "label_regex", Values: []string{"("}},
+		{Name: "enabled", Values: []string{"yes please"}},
+		{Name: "state", Values: []string{"passing"}},
+	}
+
+	for _, term := range tests {
+		if _, err := NewCheckFilter([]CheckFilterTerm{term}); err == nil {
+			t.Errorf("expected error for %+v", term)
+		}
+	}
+}
@@ -74,3 +74,30 @@ func (c *Client) DeleteCheck(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// UpdateCheckNotifications replaces the notification list of a check
+// without touching any other field. An empty list removes every
+// notification.
+func (c *Client) UpdateCheckNotifications(ctx context.Context, id, checkType string, notifications []map[string]interface{}) (*Check, error) {
+	if notifications == nil {
+		notifications = []map[string]interface{}{}
+	}
+	body := struct {
+		Type          string                   `json:"type"`
+		Notifications []map[string]interface{} `json:"notifications"`
+	}{
+		Type:          checkType,
+		Notifications: notifications,
+	}
+
+	var result Check
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodPut,
+		path:   "/checks/" + url.PathEscape(id),
+		body:   body,
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update check notifications: %w", err)
+	}
+	return &result, nil
+}
@@ -215,6 +215,176 @@ func TestUpdateCheck(t *testing.T) {
 	}
 }
 
+func TestUpdateCheckNotificationsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if len(body) != 2 {
+			t.Errorf("expected only type and notifications, got %v", body)
+		}
+		notifications, ok := body["notifications"].([]interface{})
+		if !ok || len(notifications) != 0 {
+			t.Errorf("expected empty notifications list, got %v", body["notifications"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Check{ID: "201205050153W2Q4C-0J2HSIRF", Type: "HTTP"})
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	_, err := c.UpdateCheckNotifications(context.Background(), "201205050153W2Q4C-0J2HSIRF", "HTTP", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestUpdateCheckQuietMute covers the mute sent for each quiet_status. A
+// quiet check is created with a timed mute, leaves the mute alone while
+// muted or passing, and sends the configured mute once released.
+func TestUpdateCheckQuietMute(t *testing.T) {
+	until := int64(1714567200000)
+
+	tests := []struct {
+		name     string
+		creating bool
+		mute     interface{}
+		wantSent bool
+		want     interface{}
+	}{
+		{name: "off", mute: false, wantSent: true, want: false},
+		{name: "muted on create", creating: true, mute: until, wantSent: true, want: float64(until)},
+		{name: "muted", mute: nil, wantSent: false},
+		{name: "passing", mute: nil, wantSent: false},
+		{name: "released", mute: false, wantSent: true, want: false},
+		{name: "released with mute configured", mute: true, wantSent: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				wantMethod := http.MethodPut
+				if tt.creating {
+					wantMethod = http.MethodPost
+				}
+				if r.Method != wantMethod {
+					t.Errorf("expected %s, got %s", wantMethod, r.Method)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode body: %v", err)
+				}
+				mute, sent := body["mute"]
+				if sent != tt.wantSent {
+					t.Errorf("expected mute sent %v, got body %v", tt.wantSent, body)
+				}
+				if sent && mute != tt.want {
+					t.Errorf("expected mute %v, got %v", tt.want, mute)
+				}
+
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(Check{ID: "201205050153W2Q4C-0J2HSIRF", Type: "HTTP"})
+			}))
+			defer server.Close()
+
+			c := NewClient(ClientConfig{
+				APIToken: "test-token",
+				BaseURL:  server.URL,
+			})
+
+			req := CheckCreateRequest{Type: "HTTP", Target: "https://example.com", Mute: tt.mute}
+			var err error
+			if tt.creating {
+				_, err = c.CreateCheck(context.Background(), req)
+			} else {
+				_, err = c.UpdateCheck(context.Background(), "201205050153W2Q4C-0J2HSIRF", CheckUpdateRequest{CheckCreateRequest: req})
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestUpdateCheckDecommission covers the decommission payload: only the
+// type, a disabled flag and, when tagging, the tags are sent.
+func TestUpdateCheckDecommission(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		wantKeys []string
+	}{
+		{name: "disable only", wantKeys: []string{"enabled", "type"}},
+		{name: "disable and tag", tags: []string{"web", "decommissioned"}, wantKeys: []string{"enabled", "tags", "type"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("expected PUT, got %s", r.Method)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode body: %v", err)
+				}
+				if len(body) != len(tt.wantKeys) {
+					t.Errorf("expected keys %v, got body %v", tt.wantKeys, body)
+				}
+				for _, key := range tt.wantKeys {
+					if _, ok := body[key]; !ok {
+						t.Errorf("expected %q in body, got %v", key, body)
+					}
+				}
+				if body["type"] != "HTTP" {
+					t.Errorf("expected type HTTP, got %v", body["type"])
+				}
+				if body["enabled"] != "false" {
+					t.Errorf("expected enabled \"false\", got %v", body["enabled"])
+				}
+				if tt.tags != nil {
+					tags, _ := body["tags"].([]interface{})
+					if len(tags) != len(tt.tags) || tags[1] != "decommissioned" {
+						t.Errorf("expected tags %v, got %v", tt.tags, body["tags"])
+					}
+				}
+
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(Check{ID: "201205050153W2Q4C-0J2HSIRF", Type: "HTTP"})
+			}))
+			defer server.Close()
+
+			c := NewClient(ClientConfig{
+				APIToken: "test-token",
+				BaseURL:  server.URL,
+			})
+
+			_, err := c.UpdateCheck(context.Background(), "201205050153W2Q4C-0J2HSIRF", CheckUpdateRequest{
+				CheckCreateRequest: CheckCreateRequest{
+					Type:    "HTTP",
+					Enabled: "false",
+					Tags:    tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestDeleteCheck(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
@@ -236,3 +406,56 @@ func TestDeleteCheck(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestCheckCreateRequestExtraParameters(t *testing.T) {
+	req := CheckCreateRequest{
+		Type:        "DNS",
+		Target:      "8.8.8.8",
+		SendHeaders: map[string]string{"Accept": "text/plain"},
+		ExtraParameters: map[string]interface{}{
+			"edns":        map[string]interface{}{"nsid": ""},
+			"sendheaders": map[string]interface{}{"X-Extra": "1"},
+			"target":      "1.1.1.1",
+		},
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatalf("failed to decode request: %v", err)
+	}
+
+	if body["target"] != "1.1.1.1" {
+		t.Errorf("expected extra parameters to override target, got %v", body["target"])
+	}
+	if _, ok := body["edns"].(map[string]interface{}); !ok {
+		t.Errorf("expected edns object in request, got %v", body["edns"])
+	}
+	headers, ok := body["sendheaders"].(map[string]interface{})
+	if !ok || headers["Accept"] != "text/plain" || headers["X-Extra"] != "1" {
+		t.Errorf("expected sendheaders to be deep-merged, got %v", body["sendheaders"])
+	}
+}
+
+func TestCheckParametersRaw(t *testing.T) {
+	data := []byte(`{"target":"https://example.com","threshold":"5","edns":{"nsid":""}}`)
+
+	var params CheckParameters
+	if err := json.Unmarshal(data, &params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if params.Target != "https://example.com" {
+		t.Errorf("expected target 'https://example.com', got %q", params.Target)
+	}
+	if _, ok := params.Raw["edns"]; !ok {
+		t.Error("expected raw parameters to include edns")
+	}
+	if params.Raw["threshold"] != "5" {
+		t.Errorf("expected raw threshold '5', got %v", params.Raw["threshold"])
+	}
+}
@@ -36,6 +36,13 @@ type Client struct {
 	retryMaxWait time.Duration
 	userAgent    string
 	defaultTags  []string
+	managedTag   string
+	protectTags  []string
+	maskPII      bool
+	piiKey       []byte
+	locks        *keyedMutex
+	targets      *targetCache
+	accounts     *accountCache
 }
 
 type ClientConfig struct {
@@ -49,6 +56,10 @@ type ClientConfig struct {
 	Timeout      time.Duration
 	UserAgent    string
 	DefaultTags  []string
+	ManagedTag   string
+	ProtectTags  []string
+	MaskPII      bool
+	PIIKey       string
 }
 
 func NewClient(cfg ClientConfig) *Client {
@@ -87,6 +98,13 @@ func NewClient(cfg ClientConfig) *Client {
 		retryMaxWait: cfg.RetryMaxWait,
 		userAgent:    cfg.UserAgent,
 		defaultTags:  cfg.DefaultTags,
+		managedTag:   cfg.ManagedTag,
+		protectTags:  cfg.ProtectTags,
+		maskPII:      cfg.MaskPII,
+		piiKey:       []byte(cfg.PIIKey),
+		locks:        newKeyedMutex(),
+		targets:      newTargetCache(),
+		accounts:     newAccountCache(),
 	}
 }
 
@@ -102,6 +120,13 @@ func (c *Client) WithCustomerID(customerID string) *Client {
 		retryMaxWait: c.retryMaxWait,
 		userAgent:    c.userAgent,
 		defaultTags:  c.defaultTags,
+		managedTag:   c.managedTag,
+		protectTags:  c.protectTags,
+		maskPII:      c.maskPII,
+		piiKey:       c.piiKey,
+		locks:        c.locks,
+		targets:      c.targets,
+		accounts:     c.accounts,
 	}
 }
 
@@ -109,6 +134,22 @@ func (c *Client) GetDefaultTags() []string {
 	return c.defaultTags
 }
 
+// GetManagedTag returns the tag marking checks managed by the provider, or
+// "" when none is set.
+func (c *Client) GetManagedTag() string {
+	return c.managedTag
+}
+
+// GetProtectTags returns the tags that protect a check from deletion.
+func (c *Client) GetProtectTags() []string {
+	return c.protectTags
+}
+
+// GetMaskPII reports whether data sources mask contact addresses.
+func (c *Client) GetMaskPII() bool {
+	return c.maskPII
+}
+
 type requestOptions struct {
 	method     string
 	path       string
@@ -126,10 +167,20 @@ func (c *Client) doRequest(ctx context.Context, opts requestOptions, result inte
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		if attempt > 0 {
 			waitTime := c.calculateBackoff(attempt)
+			// Sleeping past the caller's deadline only to fail afterwards
+			// is pointless. Give up now, reporting both the deadline, so
+			// callers can still tell a timeout from an API failure, and
+			// the last API error.
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < waitTime {
+				return fmt.Errorf("retry budget exhausted after %d attempts: %w (last error: %w)", attempt, context.DeadlineExceeded, lastErr)
+			}
+
+			timer := time.NewTimer(waitTime)
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(waitTime):
+				timer.Stop()
+				return fmt.Errorf("%w (last error: %v)", ctx.Err(), lastErr)
+			case <-timer.C:
 			}
 		}
 
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -297,3 +298,78 @@ func TestCalculateBackoff(t *testing.T) {
 		t.Errorf("backoff should be capped at max, got %v", backoff10)
 	}
 }
+
+func TestLockSharedAcrossCustomerClients(t *testing.T) {
+	c := NewClient(ClientConfig{APIToken: "test-token"})
+	sub := c.WithCustomerID("sub")
+
+	unlock := c.Lock("contact", "ABC")
+
+	acquired := make(chan struct{})
+	go func() {
+		release := sub.Lock("contact", "ABC")
+		close(acquired)
+		release()
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected lock to be held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected lock to be released")
+	}
+
+	c.Lock("contact", "OTHER")()
+}
+
+func TestDoRequestRetryRespectsDeadline(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "temporarily unavailable"})
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken:     "test-token",
+		BaseURL:      server.URL,
+		MaxRetries:   3,
+		RetryMinWait: 5 * time.Second,
+		RetryMaxWait: 5 * time.Second,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/test",
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected doRequest to give up before the backoff, took %s", elapsed)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the last API error to be wrapped, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded to be wrapped, got %v", err)
+	}
+}
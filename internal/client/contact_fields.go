@@ -0,0 +1,54 @@
+package client
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// AddressIDs returns the IDs of the contact's addresses in sorted order,
+// so lists built from them are stable between reads.
+func (c Contact) AddressIDs() []string {
+	ids := make([]string, 0, len(c.Addresses))
+	for id := range c.Addresses {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Muted reports whether notifications to the address are muted. The API
+// sends either a boolean or the timestamp the mute expires.
+func (a ContactAddress) Muted() bool {
+	if a.Mute == nil {
+		return false
+	}
+
+	var mute interface{}
+	if err := json.Unmarshal(a.Mute, &mute); err != nil {
+		return false
+	}
+	switch v := mute.(type) {
+	case bool:
+		return v
+	case float64:
+		return v > 0
+	}
+	return false
+}
+
+// DataString returns the webhook request body as a string. The API sends
+// it either as a string or as a JSON object.
+func (a ContactAddress) DataString() string {
+	switch v := a.Data.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+}
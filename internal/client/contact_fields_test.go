@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestContactAddressFieldHelpers(t *testing.T) {
+	var addresses map[string]ContactAddress
+	err := json.Unmarshal([]byte(`{
+		"A": {"type": "webhook", "mute": true, "data": {"text": "down"}},
+		"B": {"type": "email", "mute": 1700000000000, "data": "{\"a\": 1}"},
+		"C": {"type": "sms", "mute": false},
+		"D": {"type": "sms"}
+	}`), &addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		id    string
+		muted bool
+		data  string
+	}{
+		{"A", true, `{"text":"down"}`},
+		{"B", true, `{"a": 1}`},
+		{"C", false, ""},
+		{"D", false, ""},
+	}
+
+	for _, tt := range tests {
+		addr := addresses[tt.id]
+		if got := addr.Muted(); got != tt.muted {
+			t.Errorf("%s: expected muted %v, got %v", tt.id, tt.muted, got)
+		}
+		if got := addr.DataString(); got != tt.data {
+			t.Errorf("%s: expected data %q, got %q", tt.id, tt.data, got)
+		}
+	}
+}
+
+func TestContactAddressIDs(t *testing.T) {
+	contact := Contact{Addresses: map[string]ContactAddress{"C": {}, "A": {}, "B": {}}}
+
+	ids := contact.AddressIDs()
+	if len(ids) != 3 || ids[0] != "A" || ids[1] != "B" || ids[2] != "C" {
+		t.Errorf("expected sorted IDs, got %v", ids)
+	}
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListContactGroups(ctx context.Context) (map[string]ContactGroup, error) {
+	var result map[string]ContactGroup
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/contactgroups",
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact groups: %w", err)
+	}
+	return result, nil
+}
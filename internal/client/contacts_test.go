@@ -219,3 +219,25 @@ func TestDeleteContact(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestContactUpdateRequestAddresses(t *testing.T) {
+	data, err := json.Marshal(ContactUpdateRequest{Name: "Contact"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(data, &body)
+	if _, ok := body["addresses"]; ok {
+		t.Errorf("expected nil addresses to be omitted, got %s", data)
+	}
+
+	data, err = json.Marshal(ContactUpdateRequest{Addresses: map[string]ContactAddress{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body = nil
+	json.Unmarshal(data, &body)
+	if addrs, ok := body["addresses"].(map[string]interface{}); !ok || len(addrs) != 0 {
+		t.Errorf("expected empty addresses object, got %s", data)
+	}
+}
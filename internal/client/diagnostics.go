@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Diagnostic tools the API can run from a probe.
+var DiagnosticTools = []string{"ping", "mtr", "dig", "traceroute"}
+
+// DiagnosticRequest asks a probe to run a diagnostic tool. It runs against
+// the target of CheckID, or against Target when no check is given.
+type DiagnosticRequest struct {
+	CheckID  string
+	Target   string
+	Tool     string
+	Location string
+	// Count is the number of pings to send.
+	Count int
+	// DNSServer and DNSType apply to dig.
+	DNSServer string
+	DNSType   string
+}
+
+// DiagnosticResult is the output of a diagnostic run. Raw holds the whole
+// API response, as its fields depend on the tool.
+type DiagnosticResult struct {
+	Tool     string
+	Location string
+	Target   string
+	Output   string
+	Error    string
+	Raw      json.RawMessage
+}
+
+// RunDiagnostic runs a diagnostic tool from a probe and waits for its
+// output.
+func (c *Client) RunDiagnostic(ctx context.Context, req DiagnosticRequest) (*DiagnosticResult, error) {
+	query := url.Values{}
+	query.Set("tool", req.Tool)
+	query.Set("location", req.Location)
+	if req.Target != "" {
+		query.Set("target", req.Target)
+	}
+	if req.Count > 0 {
+		query.Set("count", intToString(req.Count))
+	}
+	if req.DNSServer != "" {
+		query.Set("dnsserver", req.DNSServer)
+	}
+	if req.DNSType != "" {
+		query.Set("dnstype", req.DNSType)
+	}
+
+	path := "/diagnostics"
+	if req.CheckID != "" {
+		path += "/" + url.PathEscape(req.CheckID)
+	}
+
+	var raw json.RawMessage
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   path,
+		query:  query,
+	}, &raw)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() && req.CheckID != "" {
+			return nil, &NotFoundError{ResourceType: "check", ResourceID: req.CheckID}
+		}
+		return nil, fmt.Errorf("failed to run %s diagnostic: %w", req.Tool, err)
+	}
+
+	return parseDiagnosticResult(req, raw), nil
+}
+
+// parseDiagnosticResult picks the common fields out of a diagnostic
+// response. Output may come as text or as a list of lines.
+func parseDiagnosticResult(req DiagnosticRequest, raw json.RawMessage) *DiagnosticResult {
+	result := &DiagnosticResult{
+		Tool:     req.Tool,
+		Location: req.Location,
+		Target:   req.Target,
+		Raw:      raw,
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		result.Output = string(raw)
+		return result
+	}
+
+	if s, ok := fields["location"].(string); ok && s != "" {
+		result.Location = s
+	}
+	if s, ok := fields["target"].(string); ok && s != "" {
+		result.Target = s
+	}
+	if s, ok := fields["error"].(string); ok {
+		result.Error = s
+	}
+
+	for _, key := range []string{"result", "output"} {
+		switch v := fields[key].(type) {
+		case string:
+			result.Output = v
+		case []interface{}:
+			lines := make([]string, 0, len(v))
+			for _, line := range v {
+				lines = append(lines, fmt.Sprint(line))
+			}
+			result.Output = strings.Join(lines, "\n")
+		default:
+			continue
+		}
+		break
+	}
+
+	return result
+}
+
+// Lines returns the output split into lines, without trailing blank ones.
+func (r *DiagnosticResult) Lines() []string {
+	output := strings.TrimRight(r.Output, "\n")
+	if output == "" {
+		return []string{}
+	}
+	return strings.Split(output, "\n")
+}
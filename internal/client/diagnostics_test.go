@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRunDiagnostic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/diagnostics/201205050153W2Q4C-0J2HSIRF" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("tool") != "ping" || q.Get("location") != "wa" || q.Get("count") != "3" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"location":"wa","target":"example.com","result":"PING example.com\n3 packets transmitted, 3 received\n"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	result, err := c.RunDiagnostic(context.Background(), DiagnosticRequest{
+		CheckID:  "201205050153W2Q4C-0J2HSIRF",
+		Tool:     "ping",
+		Location: "wa",
+		Count:    3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Target != "example.com" {
+		t.Errorf("expected target from response, got %q", result.Target)
+	}
+	if lines := result.Lines(); len(lines) != 2 || lines[1] != "3 packets transmitted, 3 received" {
+		t.Errorf("unexpected lines: %q", lines)
+	}
+	if len(result.Raw) == 0 {
+		t.Error("expected raw response")
+	}
+}
+
+func TestRunDiagnosticTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/diagnostics" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("target"); got != "8.8.8.8" {
+			t.Errorf("expected target 8.8.8.8, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"output":["HOST  Loss%","1. gw  0.0%"]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	result, err := c.RunDiagnostic(context.Background(), DiagnosticRequest{
+		Target:   "8.8.8.8",
+		Tool:     "mtr",
+		Location: "nam",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Output != "HOST  Loss%\n1. gw  0.0%" {
+		t.Errorf("unexpected output %q", result.Output)
+	}
+	if result.Location != "nam" {
+		t.Errorf("expected requested location, got %q", result.Location)
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"sync"
+)
+
+// keyedMutex hands out one mutex per key so read-modify-write sequences
+// against the same API object are serialized across resources.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*sync.Mutex)}
+}
+
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	m, ok := k.locks[key]
+	if !ok {
+		m = &sync.Mutex{}
+		k.locks[key] = m
+	}
+	k.mu.Unlock()
+
+	m.Lock()
+	return m.Unlock
+}
+
+// Lock serializes read-modify-write updates of a single API object, such as
+// a contact's address list or a check's notifications, within the provider
+// process. Separate provider processes, such as two workspaces applying at
+// once, are not coordinated and can still overwrite each other's changes.
+// It returns the function that releases the lock.
+func (c *Client) Lock(objectType, id string) func() {
+	return c.locks.lock(objectType + "/" + id)
+}
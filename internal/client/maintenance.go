@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Maintenance is a maintenance schedule. Scheduled maintenance repeats on
+// Cron for Duration minutes; ad-hoc maintenance has no Cron and starts
+// when it is created.
+type Maintenance struct {
+	ID        string   `json:"_id"`
+	Name      string   `json:"name"`
+	Enabled   bool     `json:"enabled"`
+	Cron      string   `json:"cron,omitempty"`
+	Duration  int64    `json:"duration"`
+	CheckList []string `json:"checklist"`
+}
+
+// ListMaintenance returns the account's maintenance schedules keyed by ID.
+func (c *Client) ListMaintenance(ctx context.Context) (map[string]Maintenance, error) {
+	var result map[string]Maintenance
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/maintenance",
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListMaintenance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/maintenance" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"NZT101":{"_id":"201205050153W2Q4C-NZT101","name":"Weekly patching","enabled":true,"cron":"0 3 * * 0","duration":60,"checklist":["201205050153W2Q4C-0J2HSIRF"]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	schedules, err := c.ListMaintenance(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m, ok := schedules["NZT101"]
+	if !ok {
+		t.Fatalf("expected schedule NZT101, got %v", schedules)
+	}
+	if !m.Enabled || m.Cron != "0 3 * * 0" || m.Duration != 60 {
+		t.Errorf("unexpected schedule: %+v", m)
+	}
+	if len(m.CheckList) != 1 || m.CheckList[0] != "201205050153W2Q4C-0J2HSIRF" {
+		t.Errorf("unexpected checklist: %v", m.CheckList)
+	}
+}
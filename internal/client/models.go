@@ -59,6 +59,38 @@ type ContactUpdateRequest struct {
 	NewAddresses []NewAddress              `json:"newaddresses,omitempty"`
 }
 
+// MarshalJSON omits addresses only when the map is nil. An empty, non-nil
+// map is sent as {} so the last remaining address can be removed.
+func (r ContactUpdateRequest) MarshalJSON() ([]byte, error) {
+	type contactUpdateRequest ContactUpdateRequest
+	body := struct {
+		contactUpdateRequest
+		Addresses *map[string]ContactAddress `json:"addresses,omitempty"`
+	}{contactUpdateRequest: contactUpdateRequest(r)}
+	if r.Addresses != nil {
+		body.Addresses = &r.Addresses
+	}
+	return json.Marshal(body)
+}
+
+type ContactGroup struct {
+	ID         string   `json:"_id,omitempty"`
+	CustomerID string   `json:"customer_id,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Members    []string `json:"members,omitempty"`
+}
+
+// Schedule maps day names to the notification window for that day.
+type Schedule map[string]ScheduleDay
+
+type ScheduleDay struct {
+	Time     string      `json:"time,omitempty"`
+	Exclude  interface{} `json:"exclude,omitempty"`
+	Disabled interface{} `json:"disabled,omitempty"`
+	AllDay   interface{} `json:"allday,omitempty"`
+}
+
 type Check struct {
 	ID            string                   `json:"_id,omitempty"`
 	Rev           string                   `json:"_rev,omitempty"`
@@ -134,6 +166,20 @@ type CheckParameters struct {
 	SNMPCom        string                `json:"snmpcom,omitempty"`
 	VerifyVolume   interface{}           `json:"verifyvolume,omitempty"`
 	VolumeMin      interface{}           `json:"volumemin,omitempty"`
+
+	// Raw holds every parameter as returned by the API, including those
+	// without a typed field above.
+	Raw map[string]interface{} `json:"-"`
+}
+
+func (p *CheckParameters) UnmarshalJSON(data []byte) error {
+	type checkParameters CheckParameters
+	var params checkParameters
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+	*p = CheckParameters(params)
+	return json.Unmarshal(data, &p.Raw)
 }
 
 type CheckField struct {
@@ -210,12 +256,79 @@ type CheckCreateRequest struct {
 	SNMPCom        string                   `json:"snmpcom,omitempty"`
 	VerifyVolume   interface{}              `json:"verifyvolume,omitempty"`
 	VolumeMin      interface{}              `json:"volumemin,omitempty"`
+
+	// ExtraParameters is deep-merged into the request body, allowing
+	// parameters that have no typed field above.
+	ExtraParameters map[string]interface{} `json:"-"`
+}
+
+func (r CheckCreateRequest) MarshalJSON() ([]byte, error) {
+	type checkCreateRequest CheckCreateRequest
+	data, err := json.Marshal(checkCreateRequest(r))
+	if err != nil || len(r.ExtraParameters) == 0 {
+		return data, err
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	return json.Marshal(DeepMerge(body, r.ExtraParameters))
+}
+
+// DeepMerge merges src into dst and returns dst. Nested objects are merged
+// recursively; any other value in src replaces the value in dst.
+func DeepMerge(dst, src map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{}, len(src))
+	}
+	for key, srcVal := range src {
+		srcMap, srcIsMap := srcVal.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			dst[key] = DeepMerge(dstMap, srcMap)
+			continue
+		}
+		dst[key] = srcVal
+	}
+	return dst
 }
 
 type CheckUpdateRequest struct {
 	CheckCreateRequest
 }
 
+// CheckResult is a single run of a check. Times are in milliseconds since
+// the epoch.
+type CheckResult struct {
+	ID         string      `json:"_id,omitempty"`
+	CheckID    string      `json:"ci,omitempty"`
+	Type       string      `json:"t,omitempty"`
+	Target     string      `json:"tg,omitempty"`
+	Start      int64       `json:"s,omitempty"`
+	End        int64       `json:"e,omitempty"`
+	RunTime    interface{} `json:"rt,omitempty"`
+	StatusCode interface{} `json:"sc,omitempty"`
+	Message    string      `json:"m,omitempty"`
+	Success    bool        `json:"su"`
+	Location   interface{} `json:"l,omitempty"`
+}
+
+// SentNotification is one entry of the account's notification log. Time
+// is in milliseconds since the epoch.
+type SentNotification struct {
+	CheckID string `json:"check,omitempty"`
+	Time    int64  `json:"time,omitempty"`
+	// Type is the event notified about, such as down, up or first.
+	Type string `json:"type,omitempty"`
+	// ContactID is the ID of the contact address notified.
+	ContactID   string `json:"contact,omitempty"`
+	Method      string `json:"via,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
 type Notification struct {
 	Delay    int    `json:"delay"`
 	Schedule string `json:"schedule"`
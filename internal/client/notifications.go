@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// NotificationHistoryQuery selects the sent notifications to fetch. Zero
+// fields are left to the API's defaults.
+type NotificationHistoryQuery struct {
+	CheckID string
+	// Span is how many hours back to look.
+	Span  int
+	Limit int
+}
+
+// GetNotificationHistory returns the notifications NodePing sent, newest
+// first.
+func (c *Client) GetNotificationHistory(ctx context.Context, q NotificationHistoryQuery) ([]SentNotification, error) {
+	query := url.Values{}
+	if q.CheckID != "" {
+		query.Set("id", q.CheckID)
+	}
+	if q.Span > 0 {
+		query.Set("span", intToString(q.Span))
+	}
+	if q.Limit > 0 {
+		query.Set("limit", intToString(q.Limit))
+	}
+
+	// Notifications come grouped by check ID
+	var result map[string][]SentNotification
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/notifications",
+		query:  query,
+	}, &result)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() && q.CheckID != "" {
+			return nil, &NotFoundError{ResourceType: "check", ResourceID: q.CheckID}
+		}
+		return nil, fmt.Errorf("failed to get notification history: %w", err)
+	}
+
+	var notifications []SentNotification
+	for checkID, sent := range result {
+		for _, n := range sent {
+			if n.CheckID == "" {
+				n.CheckID = checkID
+			}
+			notifications = append(notifications, n)
+		}
+	}
+	sort.SliceStable(notifications, func(i, j int) bool {
+		if notifications[i].Time != notifications[j].Time {
+			return notifications[i].Time > notifications[j].Time
+		}
+		return notifications[i].CheckID < notifications[j].CheckID
+	})
+	return notifications, nil
+}
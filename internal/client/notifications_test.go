@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetNotificationHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/notifications" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("span"); got != "48" {
+			t.Errorf("expected span 48, got %q", got)
+		}
+		if r.URL.Query().Has("id") {
+			t.Error("expected no id parameter")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"201205050153W2Q4C-0J2HSIRF": [
+				{"time": 1431969400000, "type": "down", "contact": "K5SP9CQP", "via": "email", "destination": "ops@example.com", "status": "sent"}
+			],
+			"201205050153W2Q4C-4RZT8MLN": [
+				{"time": 1431969500000, "type": "up", "contact": "K5SP9CQP", "via": "sms", "destination": "+15551234567", "status": "sent"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	notifications, err := c.GetNotificationHistory(context.Background(), NotificationHistoryQuery{Span: 48})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifications) != 2 {
+		t.Fatalf("expected 2 notifications, got %d", len(notifications))
+	}
+
+	newest := notifications[0]
+	if newest.CheckID != "201205050153W2Q4C-4RZT8MLN" {
+		t.Errorf("expected newest notification first with its check ID, got %q", newest.CheckID)
+	}
+	if newest.Type != "up" || newest.Method != "sms" || newest.Status != "sent" {
+		t.Errorf("unexpected notification: %+v", newest)
+	}
+}
+
+func TestGetNotificationHistoryNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Check not found"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	_, err := c.GetNotificationHistory(context.Background(), NotificationHistoryQuery{CheckID: "missing"})
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+)
+
+// MaskAddress redacts a contact address, keeping just enough to tell
+// addresses apart by eye: the first character and domain of an email, the
+// scheme and host of a URL, and the last four characters of anything else,
+// such as a phone number.
+func MaskAddress(address string) string {
+	if address == "" {
+		return ""
+	}
+
+	if strings.Contains(address, "://") {
+		if u, err := url.Parse(address); err == nil && u.Host != "" {
+			return u.Scheme + "://" + u.Host + "/***"
+		}
+		return "***"
+	}
+
+	if at := strings.LastIndex(address, "@"); at > 0 {
+		return address[:1] + "***" + address[at:]
+	}
+
+	runes := []rune(address)
+	if len(runes) <= 4 {
+		return "***"
+	}
+	return "***" + string(runes[len(runes)-4:])
+}
+
+// AddressFingerprint returns a stable fingerprint of a contact address:
+// the hex HMAC-SHA256 of the address, trimmed and lowercased, keyed with
+// the provider's pii_fingerprint_key. Equal addresses have equal
+// fingerprints under the same key, so they can be compared without
+// exposing them, while hashing candidate addresses such as phone numbers
+// recovers nothing without the key. It returns false when no key is set.
+func (c *Client) AddressFingerprint(address string) (string, bool) {
+	if len(c.piiKey) == 0 {
+		return "", false
+	}
+	mac := hmac.New(sha256.New, c.piiKey)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(address))))
+	return hex.EncodeToString(mac.Sum(nil)), true
+}
+
+// PresentAddress returns address as data sources should expose it: masked
+// when the provider's mask_pii is set, unchanged otherwise.
+func (c *Client) PresentAddress(address string) string {
+	if c.maskPII {
+		return MaskAddress(address)
+	}
+	return address
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestMaskAddress(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		{"devops@example.com", "d***@example.com"},
+		{"+15551234567", "***4567"},
+		{"https://hooks.slack.com/services/T000/B000/XXXX?token=secret", "https://hooks.slack.com/***"},
+		{"1234", "***"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaskAddress(tt.address); got != tt.expected {
+			t.Errorf("MaskAddress(%q) = %q, expected %q", tt.address, got, tt.expected)
+		}
+	}
+}
+
+func TestAddressFingerprint(t *testing.T) {
+	c := NewClient(ClientConfig{APIToken: "test-token", PIIKey: "fingerprint-key"})
+
+	a, ok := c.AddressFingerprint("+1 555 123 4567 ")
+	if !ok {
+		t.Fatal("expected a fingerprint when a key is set")
+	}
+	b, _ := c.WithCustomerID("SUB").AddressFingerprint("+1 555 123 4567")
+	if a != b {
+		t.Errorf("expected equal fingerprints for the same address, got %q and %q", a, b)
+	}
+	if len(a) != 64 {
+		t.Errorf("expected a 64 character fingerprint, got %q", a)
+	}
+	if other, _ := c.AddressFingerprint("+1 555 123 4568"); other == b {
+		t.Error("expected different fingerprints for different addresses")
+	}
+
+	raw := sha256.Sum256([]byte("+1 555 123 4567"))
+	if a == hex.EncodeToString(raw[:]) {
+		t.Error("expected a keyed fingerprint, got the plain SHA-256 of the address")
+	}
+
+	other := NewClient(ClientConfig{APIToken: "test-token", PIIKey: "other-key"})
+	if fp, _ := other.AddressFingerprint("+1 555 123 4567"); fp == a {
+		t.Error("expected fingerprints to depend on the key")
+	}
+
+	unkeyed := NewClient(ClientConfig{APIToken: "test-token"})
+	if fp, ok := unkeyed.AddressFingerprint("+1 555 123 4567"); ok || fp != "" {
+		t.Errorf("expected no fingerprint without a key, got %q", fp)
+	}
+}
+
+func TestPresentAddress(t *testing.T) {
+	plain := NewClient(ClientConfig{APIToken: "test-token"})
+	if got := plain.PresentAddress("devops@example.com"); got != "devops@example.com" {
+		t.Errorf("expected unmasked address, got %q", got)
+	}
+
+	masked := NewClient(ClientConfig{APIToken: "test-token", MaskPII: true}).WithCustomerID("SUB")
+	if got := masked.PresentAddress("devops@example.com"); got != "d***@example.com" {
+		t.Errorf("expected masked address, got %q", got)
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BuiltinSchedules are the notification schedules every NodePing account
+// has, whether or not the schedules endpoint lists them.
+var BuiltinSchedules = []string{"All", "Days", "Nights", "Weekdays", "Weekends"}
+
+// NotificationTargets holds every ID a check notification may reference,
+// and the schedules it may use, for one account.
+type NotificationTargets struct {
+	// Addresses maps each contact address ID to the ID of its contact.
+	Addresses map[string]string
+	Contacts  map[string]bool
+	Groups    map[string]bool
+	Schedules map[string]bool
+}
+
+// ValidateContactID returns an error unless id is a contact address ID or a
+// contact group ID.
+func (t *NotificationTargets) ValidateContactID(id string) error {
+	if _, ok := t.Addresses[id]; ok {
+		return nil
+	}
+	if t.Groups[id] {
+		return nil
+	}
+	if t.Contacts[id] {
+		return fmt.Errorf("%q is a contact ID; notifications must reference one of the contact's address IDs or a contact group ID", id)
+	}
+	return fmt.Errorf("no contact address or contact group with ID %q exists", id)
+}
+
+// ValidateSchedule returns an error unless name is a known schedule.
+func (t *NotificationTargets) ValidateSchedule(name string) error {
+	if t.Schedules[name] {
+		return nil
+	}
+	names := make([]string, 0, len(t.Schedules))
+	for s := range t.Schedules {
+		names = append(names, s)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("no notification schedule named %q exists; available schedules: %s", name, strings.Join(names, ", "))
+}
+
+// targetCache keeps one NotificationTargets per account so plan-time
+// validation costs a single round of list calls per provider run.
+type targetCache struct {
+	mu      sync.Mutex
+	entries map[string]*NotificationTargets
+}
+
+func newTargetCache() *targetCache {
+	return &targetCache{entries: make(map[string]*NotificationTargets)}
+}
+
+// NotificationTargets returns the contact addresses, contact groups and
+// schedules of the client's account. The result is fetched once and shared
+// by every client derived from the same provider configuration until
+// InvalidateNotificationTargets is called.
+func (c *Client) NotificationTargets(ctx context.Context) (*NotificationTargets, error) {
+	c.targets.mu.Lock()
+	defer c.targets.mu.Unlock()
+
+	if t, ok := c.targets.entries[c.customerID]; ok {
+		return t, nil
+	}
+
+	contacts, err := c.ListContacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.ListContactGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := c.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &NotificationTargets{
+		Addresses: make(map[string]string),
+		Contacts:  make(map[string]bool, len(contacts)),
+		Groups:    make(map[string]bool, len(groups)),
+		Schedules: make(map[string]bool, len(schedules)+len(BuiltinSchedules)),
+	}
+	for id, contact := range contacts {
+		t.Contacts[id] = true
+		for addrID := range contact.Addresses {
+			t.Addresses[addrID] = id
+		}
+	}
+	for id := range groups {
+		t.Groups[id] = true
+	}
+	for _, name := range BuiltinSchedules {
+		t.Schedules[name] = true
+	}
+	for name := range schedules {
+		t.Schedules[name] = true
+	}
+
+	c.targets.entries[c.customerID] = t
+	return t, nil
+}
+
+// InvalidateNotificationTargets drops the cached targets of the client's
+// account. Call it after creating or removing contact addresses so checks
+// planned later in the same run can reference them.
+func (c *Client) InvalidateNotificationTargets() {
+	c.targets.mu.Lock()
+	defer c.targets.mu.Unlock()
+	delete(c.targets.entries, c.customerID)
+}
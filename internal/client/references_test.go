@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNotificationTargets(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/contacts":
+			json.NewEncoder(w).Encode(map[string]Contact{
+				"201205050153W2Q4C-BKPGH": {
+					ID: "201205050153W2Q4C-BKPGH",
+					Addresses: map[string]ContactAddress{
+						"K5SP9CQP": {Type: "email", Address: "ops@example.com"},
+					},
+				},
+			})
+		case "/contactgroups":
+			json.NewEncoder(w).Encode(map[string]ContactGroup{
+				"201205050153W2Q4C-G-3QJWG": {Name: "Ops"},
+			})
+		case "/schedules":
+			json.NewEncoder(w).Encode(map[string]Schedule{
+				"Business": {"monday": {Time: "08:00-17:00"}},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	targets, err := c.NotificationTargets(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, id := range []string{"K5SP9CQP", "201205050153W2Q4C-G-3QJWG"} {
+		if err := targets.ValidateContactID(id); err != nil {
+			t.Errorf("expected %q to be valid, got %v", id, err)
+		}
+	}
+	for _, id := range []string{"201205050153W2Q4C-BKPGH", "K5SP9CQX"} {
+		if err := targets.ValidateContactID(id); err == nil {
+			t.Errorf("expected %q to be rejected", id)
+		}
+	}
+	for _, name := range []string{"All", "Business"} {
+		if err := targets.ValidateSchedule(name); err != nil {
+			t.Errorf("expected schedule %q to be valid, got %v", name, err)
+		}
+	}
+	if err := targets.ValidateSchedule("business"); err == nil {
+		t.Error("expected schedule names to be case sensitive")
+	}
+
+	// Clients for the same account share the cached lookup
+	if _, err := c.WithCustomerID("").NotificationTargets(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+
+	c.InvalidateNotificationTargets()
+	if _, err := c.NotificationTargets(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 6 {
+		t.Errorf("expected 6 requests after invalidation, got %d", got)
+	}
+}
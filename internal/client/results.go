@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetCheckResults returns the most recent results of a check, newest first.
+func (c *Client) GetCheckResults(ctx context.Context, id string, limit int) ([]CheckResult, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", intToString(limit))
+	}
+
+	var result []CheckResult
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/results/" + url.PathEscape(id),
+		query:  query,
+	}, &result)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() {
+			return nil, &NotFoundError{ResourceType: "check", ResourceID: id}
+		}
+		return nil, fmt.Errorf("failed to get check results: %w", err)
+	}
+	return result, nil
+}
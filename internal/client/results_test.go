@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetCheckResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/results/201205050153W2Q4C-0J2HSIRF" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "1" {
+			t.Errorf("expected limit 1, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"_id":"201205050153W2Q4C-0J2HSIRF-1431969400000","ci":"201205050153W2Q4C-0J2HSIRF","t":"HTTP","tg":"https://example.com","s":1431969400000,"e":1431969400123,"rt":123,"sc":"Connection refused","m":"Connection refused","su":false,"l":{"1431969400000":"wa"}}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	results, err := c.GetCheckResults(context.Background(), "201205050153W2Q4C-0J2HSIRF", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	result := results[0]
+	if result.Success {
+		t.Error("expected failed result")
+	}
+	if result.Start != 1431969400000 {
+		t.Errorf("expected start 1431969400000, got %d", result.Start)
+	}
+	if result.Message != "Connection refused" {
+		t.Errorf("expected message 'Connection refused', got %q", result.Message)
+	}
+}
+
+func TestGetCheckResultsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Check not found"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	_, err := c.GetCheckResults(context.Background(), "missing", 1)
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
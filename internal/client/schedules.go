@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListSchedules returns the account's notification schedules keyed by name.
+func (c *Client) ListSchedules(ctx context.Context) (map[string]Schedule, error) {
+	var result map[string]Schedule
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/schedules",
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fanOutConcurrency bounds the accounts ForEachAccount reads at once. The
+// shared rate limiter still paces the requests themselves.
+const fanOutConcurrency = 4
+
+// SubAccount is a SubAccount of the client's account.
+type SubAccount struct {
+	ID     string `json:"_id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// AccountFailure is an account ForEachAccount could not read.
+type AccountFailure struct {
+	// CustomerID is "" for the client's own account if it has none.
+	CustomerID string
+	Err        error
+}
+
+// Account names the account for messages.
+func (f AccountFailure) Account() string {
+	if f.CustomerID == "" {
+		return "the provider's account"
+	}
+	return "account " + f.CustomerID
+}
+
+// ListSubAccounts returns the SubAccounts of the client's account, keyed by
+// customer ID.
+func (c *Client) ListSubAccounts(ctx context.Context) (map[string]SubAccount, error) {
+	var result map[string]SubAccount
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/accounts",
+	}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subaccounts: %w", err)
+	}
+	for id, account := range result {
+		if account.ID == "" {
+			account.ID = id
+			result[id] = account
+		}
+	}
+	return result, nil
+}
+
+// ForEachAccount calls fn for the client's own account and for each of its
+// active SubAccounts, a few at a time, with a client scoped to that account.
+// The clients share the provider's rate limiter, so the fan-out never
+// exceeds rate_limit. It returns fn's errors for SubAccounts as failures,
+// sorted by customer ID. Failing to list the SubAccounts, or fn failing for
+// the client's own account or for every account, is returned as the error,
+// since the result would then miss what a single-account read returns.
+func (c *Client) ForEachAccount(ctx context.Context, fn func(ctx context.Context, customerID string, account *Client) error) ([]AccountFailure, error) {
+	subAccounts, err := c.ListSubAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerIDs := []string{c.customerID}
+	for id, account := range subAccounts {
+		if id == c.customerID {
+			continue
+		}
+		// Suspended and cancelled accounts reject requests
+		if account.Status != "" && !strings.EqualFold(account.Status, "active") {
+			continue
+		}
+		customerIDs = append(customerIDs, id)
+	}
+	sort.Strings(customerIDs[1:])
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failures []AccountFailure
+		slots    = make(chan struct{}, fanOutConcurrency)
+	)
+	for _, id := range customerIDs {
+		account := c
+		if id != c.customerID {
+			account = c.WithCustomerID(id)
+		}
+
+		wg.Add(1)
+		go func(id string, account *Client) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			if err := fn(ctx, id, account); err != nil {
+				mu.Lock()
+				failures = append(failures, AccountFailure{CustomerID: id, Err: err})
+				mu.Unlock()
+			}
+		}(id, account)
+	}
+	wg.Wait()
+
+	sort.Slice(failures, func(i, j int) bool { return failures[i].CustomerID < failures[j].CustomerID })
+
+	if len(failures) == len(customerIDs) {
+		return nil, fmt.Errorf("failed to read any of %d accounts, first %s: %w", len(customerIDs), failures[0].Account(), failures[0].Err)
+	}
+	for _, failure := range failures {
+		if failure.CustomerID == c.customerID {
+			return nil, fmt.Errorf("failed to read %s: %w", failure.Account(), failure.Err)
+		}
+	}
+	return failures, nil
+}
+
+// ListChecksAllAccounts returns the checks of the client's account and all
+// of its SubAccounts, each with its CustomerID set. SubAccounts whose
+// checks could not be listed are returned as failures.
+func (c *Client) ListChecksAllAccounts(ctx context.Context) (map[string]Check, []AccountFailure, error) {
+	var mu sync.Mutex
+	all := make(map[string]Check)
+	failures, err := c.ForEachAccount(ctx, func(ctx context.Context, customerID string, account *Client) error {
+		checks, err := account.ListChecks(ctx)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for id, check := range checks {
+			if check.CustomerID == "" {
+				check.CustomerID = customerID
+			}
+			all[id] = check
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return all, failures, nil
+}
+
+// ListContactsAllAccounts returns the contacts of the client's account and
+// all of its SubAccounts, each with its CustomerID set. SubAccounts whose
+// contacts could not be listed are returned as failures.
+func (c *Client) ListContactsAllAccounts(ctx context.Context) (map[string]Contact, []AccountFailure, error) {
+	var mu sync.Mutex
+	all := make(map[string]Contact)
+	failures, err := c.ForEachAccount(ctx, func(ctx context.Context, customerID string, account *Client) error {
+		contacts, err := account.ListContacts(ctx)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for id, contact := range contacts {
+			if contact.CustomerID == "" {
+				contact.CustomerID = customerID
+			}
+			all[id] = contact
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return all, failures, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestListChecksAllAccounts(t *testing.T) {
+	var mu sync.Mutex
+	listed := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		customerID := r.URL.Query().Get("customerid")
+		switch r.URL.Path {
+		case "/accounts":
+			if customerID != "" {
+				t.Errorf("expected no customerid when listing subaccounts, got %q", customerID)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"SUB1": {"_id": "SUB1", "name": "One", "status": "Active"},
+				"SUB2": {"name": "Two", "status": "Active"},
+				"SUB3": {"_id": "SUB3", "name": "Gone", "status": "Suspended"}
+			}`))
+		case "/checks":
+			mu.Lock()
+			listed[customerID]++
+			mu.Unlock()
+
+			switch customerID {
+			case "":
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"PRI-C1": {"_id": "PRI-C1", "customer_id": "PRI", "type": "SSL"}}`))
+			case "SUB1":
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"SUB1-C1": {"_id": "SUB1-C1", "type": "SSL"}}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"error":"Access denied"}`))
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	checks, failures, err := c.ListChecksAllAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 checks, got %d: %v", len(checks), checks)
+	}
+	if got := checks["PRI-C1"].CustomerID; got != "PRI" {
+		t.Errorf("expected the API's customer ID to be kept, got %q", got)
+	}
+	if got := checks["SUB1-C1"].CustomerID; got != "SUB1" {
+		t.Errorf("expected customer ID SUB1, got %q", got)
+	}
+
+	if len(failures) != 1 || failures[0].CustomerID != "SUB2" || failures[0].Err == nil {
+		t.Errorf("expected a failure for SUB2 only, got %v", failures)
+	} else if got := failures[0].Account(); got != "account SUB2" {
+		t.Errorf("expected account SUB2, got %q", got)
+	}
+	if listed["SUB3"] != 0 {
+		t.Error("expected the suspended account to be skipped")
+	}
+	for _, id := range []string{"", "SUB1", "SUB2"} {
+		if listed[id] != 1 {
+			t.Errorf("expected account %q to be listed once, got %d", id, listed[id])
+		}
+	}
+}
+
+func TestListContactsAllAccountsListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":"Not a primary account"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	if _, _, err := c.ListContactsAllAccounts(context.Background()); err == nil {
+		t.Error("expected an error when subaccounts cannot be listed")
+	}
+}
+
+func TestListChecksAllAccountsFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		failed map[string]bool
+	}{
+		{"all accounts", map[string]bool{"": true, "SUB1": true}},
+		{"own account", map[string]bool{"": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				customerID := r.URL.Query().Get("customerid")
+				switch {
+				case r.URL.Path == "/accounts":
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"SUB1": {"_id": "SUB1", "name": "One", "status": "Active"}}`))
+				case tt.failed[customerID]:
+					w.WriteHeader(http.StatusForbidden)
+					w.Write([]byte(`{"error":"Access denied"}`))
+				default:
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"SUB1-C1": {"_id": "SUB1-C1", "type": "SSL"}}`))
+				}
+			}))
+			defer server.Close()
+
+			c := NewClient(ClientConfig{
+				APIToken: "test-token",
+				BaseURL:  server.URL,
+			})
+
+			checks, failures, err := c.ListChecksAllAccounts(context.Background())
+			if err == nil {
+				t.Fatalf("expected an error, got checks %v and failures %v", checks, failures)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+				t.Errorf("expected the API error to be wrapped, got %v", err)
+			}
+		})
+	}
+}
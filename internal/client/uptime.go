@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// UptimeQuery selects the uptime figures to fetch. Zero fields are left to
+// the API's defaults.
+type UptimeQuery struct {
+	// Interval is the bucket size, days or months.
+	Interval string
+	Start    time.Time
+	// End is inclusive.
+	End time.Time
+}
+
+// Uptime is a check's uptime over one interval. Enabled and Down are in
+// milliseconds. Time the check was disabled, such as during a NodePing
+// maintenance window, does not count as enabled.
+type Uptime struct {
+	Enabled int64   `json:"enabled"`
+	Down    int64   `json:"down"`
+	Uptime  float64 `json:"uptime"`
+}
+
+// UptimeTotalKey is the key of the whole-query total in GetCheckUptime
+// results.
+const UptimeTotalKey = "total"
+
+// GetCheckUptime returns a check's uptime per interval, keyed by the
+// interval's date (such as 2026-09) plus UptimeTotalKey for the whole query.
+func (c *Client) GetCheckUptime(ctx context.Context, id string, q UptimeQuery) (map[string]Uptime, error) {
+	query := url.Values{}
+	if q.Interval != "" {
+		query.Set("interval", q.Interval)
+	}
+	if !q.Start.IsZero() {
+		query.Set("start", strconv.FormatInt(q.Start.UnixMilli(), 10))
+	}
+	if !q.End.IsZero() {
+		query.Set("end", strconv.FormatInt(q.End.UnixMilli(), 10))
+	}
+
+	var result map[string]Uptime
+	err := c.doRequest(ctx, requestOptions{
+		method: http.MethodGet,
+		path:   "/results/uptime/" + url.PathEscape(id),
+		query:  query,
+	}, &result)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() {
+			return nil, &NotFoundError{ResourceType: "check", ResourceID: id}
+		}
+		return nil, fmt.Errorf("failed to get check uptime: %w", err)
+	}
+	return result, nil
+}
+
+// TotalUptime returns the whole-query total of GetCheckUptime results,
+// summing the intervals if the API left the total out.
+func TotalUptime(buckets map[string]Uptime) Uptime {
+	if total, ok := buckets[UptimeTotalKey]; ok {
+		return total
+	}
+
+	var total Uptime
+	for _, b := range buckets {
+		total.Enabled += b.Enabled
+		total.Down += b.Down
+	}
+	return total
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetCheckUptime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/results/uptime/201205050153W2Q4C-0J2HSIRF" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if got := query.Get("interval"); got != "months" {
+			t.Errorf("expected interval months, got %q", got)
+		}
+		if got := query.Get("start"); got != "1756684800000" {
+			t.Errorf("expected start 1756684800000, got %q", got)
+		}
+		if got := query.Get("end"); got != "1759276799999" {
+			t.Errorf("expected end 1759276799999, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"2025-09":{"enabled":2592000000,"down":60000,"uptime":99.998},"total":{"enabled":2592000000,"down":60000,"uptime":99.998}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	start := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	buckets, err := c.GetCheckUptime(context.Background(), "201205050153W2Q4C-0J2HSIRF", UptimeQuery{
+		Interval: "months",
+		Start:    start,
+		End:      start.AddDate(0, 1, 0).Add(-time.Millisecond),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	total := TotalUptime(buckets)
+	if total.Enabled != 2592000000 || total.Down != 60000 {
+		t.Errorf("unexpected total: %+v", total)
+	}
+}
+
+func TestGetCheckUptimeNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Check not found"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		APIToken: "test-token",
+		BaseURL:  server.URL,
+	})
+
+	_, err := c.GetCheckUptime(context.Background(), "missing", UptimeQuery{})
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
+
+func TestTotalUptimeWithoutTotal(t *testing.T) {
+	total := TotalUptime(map[string]Uptime{
+		"2025-07": {Enabled: 1000, Down: 10},
+		"2025-08": {Enabled: 2000, Down: 0},
+	})
+	if total.Enabled != 3000 || total.Down != 10 {
+		t.Errorf("unexpected total: %+v", total)
+	}
+}
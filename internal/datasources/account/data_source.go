@@ -0,0 +1,141 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+var _ datasource.DataSource = &AccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountDataSource{}
+
+type AccountDataSource struct {
+	client *client.Client
+}
+
+type AccountDataSourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Plan         types.String  `tfsdk:"plan"`
+	Status       types.String  `tfsdk:"status"`
+	CheckLimit   types.Int64   `tfsdk:"check_limit"`
+	ContactLimit types.Int64   `tfsdk:"contact_limit"`
+	CheckCount   types.Int64   `tfsdk:"check_count"`
+	ContactCount types.Int64   `tfsdk:"contact_count"`
+	MinInterval  types.Float64 `tfsdk:"min_interval"`
+}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the plan, limits and usage of the NodePing account.",
+		MarkdownDescription: `
+Fetches the plan, limits and usage of the NodePing account. When the provider sets ` + "`customer_id`" + `, the SubAccount is read.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_account" "current" {}
+
+output "checks_left" {
+  value = data.nodeping_account.current.check_limit - data.nodeping_account.current.check_count
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The customer ID of the account.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the account.",
+				Computed:    true,
+			},
+			"plan": schema.StringAttribute{
+				Description: "The name of the account's plan.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the account.",
+				Computed:    true,
+			},
+			"check_limit": schema.Int64Attribute{
+				Description: "The number of checks the plan allows. 0 when the plan sets no limit.",
+				Computed:    true,
+			},
+			"contact_limit": schema.Int64Attribute{
+				Description: "The number of contacts the plan allows. 0 when the plan sets no limit.",
+				Computed:    true,
+			},
+			"check_count": schema.Int64Attribute{
+				Description: "The number of checks on the account.",
+				Computed:    true,
+			},
+			"contact_count": schema.Int64Attribute{
+				Description: "The number of contacts on the account.",
+				Computed:    true,
+			},
+			"min_interval": schema.Float64Attribute{
+				Description: "The shortest check interval, in minutes, the plan allows. 0 when the plan sets no minimum.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading account data source")
+
+	info, err := d.client.GetAccountInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			"Could not read account info: "+err.Error(),
+		)
+		return
+	}
+
+	state := AccountDataSourceModel{
+		ID:           types.StringValue(info.ID),
+		Name:         types.StringValue(info.Name),
+		Plan:         types.StringValue(info.Plan),
+		Status:       types.StringValue(info.Status),
+		CheckLimit:   types.Int64Value(int64(info.Limits.Checks)),
+		ContactLimit: types.Int64Value(int64(info.Limits.Contacts)),
+		CheckCount:   types.Int64Value(int64(info.Counts.Checks)),
+		ContactCount: types.Int64Value(int64(info.Counts.Contacts)),
+		MinInterval:  types.Float64Value(info.Limits.MinInterval),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
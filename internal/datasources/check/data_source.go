@@ -2,39 +2,81 @@ package check
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	checkresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/check"
 )
 
 var _ datasource.DataSource = &CheckDataSource{}
 var _ datasource.DataSourceWithConfigure = &CheckDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &CheckDataSource{}
 
 type CheckDataSource struct {
 	client *client.Client
 }
 
 type CheckDataSourceModel struct {
-	ID          types.String  `tfsdk:"id"`
-	CustomerID  types.String  `tfsdk:"customer_id"`
-	Type        types.String  `tfsdk:"type"`
-	Target      types.String  `tfsdk:"target"`
-	Label       types.String  `tfsdk:"label"`
-	Enabled     types.Bool    `tfsdk:"enabled"`
-	Public      types.Bool    `tfsdk:"public"`
-	Interval    types.Float64 `tfsdk:"interval"`
-	Threshold   types.Int64   `tfsdk:"threshold"`
-	Sens        types.Int64   `tfsdk:"sens"`
-	State       types.Int64   `tfsdk:"state"`
-	Created     types.Int64   `tfsdk:"created"`
-	Modified    types.Int64   `tfsdk:"modified"`
-	Description types.String  `tfsdk:"description"`
-	Tags        types.List    `tfsdk:"tags"`
+	ID             types.String                      `tfsdk:"id"`
+	CustomerID     types.String                      `tfsdk:"customer_id"`
+	Type           types.String                      `tfsdk:"type"`
+	Target         types.String                      `tfsdk:"target"`
+	Label          types.String                      `tfsdk:"label"`
+	Enabled        types.Bool                        `tfsdk:"enabled"`
+	Public         types.Bool                        `tfsdk:"public"`
+	Interval       types.Float64                     `tfsdk:"interval"`
+	Threshold      types.Int64                       `tfsdk:"threshold"`
+	Sens           types.Int64                       `tfsdk:"sens"`
+	State          types.Int64                       `tfsdk:"state"`
+	Created        types.Int64                       `tfsdk:"created"`
+	Modified       types.Int64                       `tfsdk:"modified"`
+	Description    types.String                      `tfsdk:"description"`
+	Tags           types.List                        `tfsdk:"tags"`
+	Mute           types.Bool                        `tfsdk:"mute"`
+	Dep            types.String                      `tfsdk:"dep"`
+	RunLocations   types.List                        `tfsdk:"runlocations"`
+	HomeLoc        types.String                      `tfsdk:"homeloc"`
+	AutoDiag       types.Bool                        `tfsdk:"autodiag"`
+	Notifications  []checkresource.NotificationModel `tfsdk:"notifications"`
+	ContentString  types.String                      `tfsdk:"contentstring"`
+	Regex          types.Bool                        `tfsdk:"regex"`
+	Invert         types.Bool                        `tfsdk:"invert"`
+	Follow         types.Bool                        `tfsdk:"follow"`
+	Method         types.String                      `tfsdk:"method"`
+	StatusCode     types.Int64                       `tfsdk:"statuscode"`
+	SendHeaders    types.Map                         `tfsdk:"sendheaders"`
+	ReceiveHeaders types.Map                         `tfsdk:"receiveheaders"`
+	PostData       types.String                      `tfsdk:"postdata"`
+	Port           types.Int64                       `tfsdk:"port"`
+	Username       types.String                      `tfsdk:"username"`
+	Secure         types.String                      `tfsdk:"secure"`
+	Verify         types.Bool                        `tfsdk:"verify"`
+	IPv6           types.Bool                        `tfsdk:"ipv6"`
+	DNSType        types.String                      `tfsdk:"dnstype"`
+	DNSToResolve   types.String                      `tfsdk:"dnstoresolve"`
+	DNSSection     types.String                      `tfsdk:"dnssection"`
+	DNSRD          types.Bool                        `tfsdk:"dnsrd"`
+	Transport      types.String                      `tfsdk:"transport"`
+	WarningDays    types.Int64                       `tfsdk:"warningdays"`
+	ServerName     types.String                      `tfsdk:"servername"`
+	Email          types.String                      `tfsdk:"email"`
+	Database       types.String                      `tfsdk:"database"`
+	Query          types.String                      `tfsdk:"query"`
+	Namespace      types.String                      `tfsdk:"namespace"`
+	SSHKey         types.String                      `tfsdk:"sshkey"`
+	ClientCert     types.String                      `tfsdk:"clientcert"`
+	SNMPv          types.String                      `tfsdk:"snmpv"`
+
+	ExtraParametersJSON types.String `tfsdk:"extra_parameters_json"`
 }
 
 func NewCheckDataSource() datasource.DataSource {
@@ -47,9 +89,9 @@ func (d *CheckDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 
 func (d *CheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a NodePing check by ID.",
+		Description: "Fetches a NodePing check by ID, label, target or tags.",
 		MarkdownDescription: `
-Fetches a NodePing check by ID.
+Fetches a NodePing check by ID, label, target or tags. Exactly one of these must be set, and the lookup must match exactly one check.
 
 ## Example Usage
 
@@ -58,6 +100,10 @@ data "nodeping_check" "example" {
   id = "201205050153W2Q4C-0J2HSIRF"
 }
 
+data "nodeping_check" "api" {
+  label = "api.example.com"
+}
+
 output "check_state" {
   value = data.nodeping_check.example.state
 }
@@ -65,8 +111,9 @@ output "check_state" {
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The unique identifier of the check.",
-				Required:    true,
+				Description: "The unique identifier of the check. Conflicts with label, target and tags.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"customer_id": schema.StringAttribute{
 				Description: "The customer ID (account ID) that owns this check.",
@@ -77,11 +124,13 @@ output "check_state" {
 				Computed:    true,
 			},
 			"target": schema.StringAttribute{
-				Description: "The target URL, hostname, or IP address.",
+				Description: "The target URL, hostname, or IP address. Set it to look the check up by exact target.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"label": schema.StringAttribute{
-				Description: "The label for the check.",
+				Description: "The label for the check. Set it to look the check up by exact label.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"enabled": schema.BoolAttribute{
@@ -121,14 +170,187 @@ output "check_state" {
 				Computed:    true,
 			},
 			"tags": schema.ListAttribute{
-				Description: "Tags for the check.",
+				Description: "Tags for the check. Set it to look up the check carrying all of these tags; the configured list is then kept as is.",
+				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"mute": schema.BoolAttribute{
+				Description: "Whether notifications for the check are muted.",
+				Computed:    true,
+			},
+			"dep": schema.StringAttribute{
+				Description: "Check ID for notification dependency.",
+				Computed:    true,
+			},
+			"runlocations": schema.ListAttribute{
+				Description: "Probe locations the check runs from.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"homeloc": schema.StringAttribute{
+				Description: "Preferred probe location for the check.",
+				Computed:    true,
+			},
+			"autodiag": schema.BoolAttribute{
+				Description: "Whether automated diagnostics are enabled.",
+				Computed:    true,
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description: "Notifications for the check.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_id": schema.StringAttribute{
+							Description: "The contact address ID.",
+							Computed:    true,
+						},
+						"delay": schema.Int64Attribute{
+							Description: "Minutes to wait before notifying.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "The notification schedule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"contentstring": schema.StringAttribute{
+				Description: "String to match in the response.",
+				Computed:    true,
+			},
+			"regex": schema.BoolAttribute{
+				Description: "Whether contentstring is a regular expression.",
+				Computed:    true,
+			},
+			"invert": schema.BoolAttribute{
+				Description: "Whether the content match is inverted (does not contain).",
+				Computed:    true,
+			},
+			"follow": schema.BoolAttribute{
+				Description: "Whether redirects are followed (HTTP checks).",
+				Computed:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "HTTP method for HTTPADV checks.",
+				Computed:    true,
+			},
+			"statuscode": schema.Int64Attribute{
+				Description: "Expected HTTP status code.",
+				Computed:    true,
+			},
+			"sendheaders": schema.MapAttribute{
+				Description: "HTTP headers sent with the request.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"receiveheaders": schema.MapAttribute{
+				Description: "Expected HTTP headers in the response.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"postdata": schema.StringAttribute{
+				Description: "POST data for HTTPADV checks.",
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port number for the check.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username for authentication.",
+				Computed:    true,
+			},
+			"secure": schema.StringAttribute{
+				Description: "SSL/TLS mode: 'false', 'ssl', or 'starttls'.",
+				Computed:    true,
+			},
+			"verify": schema.BoolAttribute{
+				Description: "Whether the SSL certificate or DNSSEC is verified.",
+				Computed:    true,
+			},
+			"ipv6": schema.BoolAttribute{
+				Description: "Whether the check uses IPv6.",
+				Computed:    true,
+			},
+			"dnstype": schema.StringAttribute{
+				Description: "DNS query type.",
+				Computed:    true,
+			},
+			"dnstoresolve": schema.StringAttribute{
+				Description: "FQDN to resolve in DNS checks.",
+				Computed:    true,
+			},
+			"dnssection": schema.StringAttribute{
+				Description: "DNS reply section to check.",
+				Computed:    true,
+			},
+			"dnsrd": schema.BoolAttribute{
+				Description: "DNS Recursion Desired bit.",
+				Computed:    true,
+			},
+			"transport": schema.StringAttribute{
+				Description: "Transport protocol for DNS/SIP checks.",
+				Computed:    true,
+			},
+			"warningdays": schema.Int64Attribute{
+				Description: "Days before certificate/domain expiry to fail.",
+				Computed:    true,
+			},
+			"servername": schema.StringAttribute{
+				Description: "Server name for SNI in SSL checks.",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address for SMTP checks.",
+				Computed:    true,
+			},
+			"database": schema.StringAttribute{
+				Description: "Database name for database checks.",
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Query for database checks.",
+				Computed:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "MongoDB collection namespace.",
+				Computed:    true,
+			},
+			"sshkey": schema.StringAttribute{
+				Description: "SSH private key ID for SSH checks.",
+				Computed:    true,
+			},
+			"clientcert": schema.StringAttribute{
+				Description: "Client certificate ID for HTTPADV/DOHDOT checks.",
+				Computed:    true,
+			},
+			"snmpv": schema.StringAttribute{
+				Description: "SNMP version ('1' or '2c').",
+				Computed:    true,
+			},
+			"extra_parameters_json": schema.StringAttribute{
+				Description: "JSON object of every check parameter without a dedicated attribute. Secrets, such as Redis host passwords, are left out.",
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
 
+func (d *CheckDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("label"),
+			path.MatchRoot("target"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
 func (d *CheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -154,53 +376,180 @@ func (d *CheckDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	tflog.Debug(ctx, "Reading check data source", map[string]interface{}{
-		"id": config.ID.ValueString(),
+		"id":     config.ID.ValueString(),
+		"label":  config.Label.ValueString(),
+		"target": config.Target.ValueString(),
 	})
 
-	check, err := d.client.GetCheck(ctx, config.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Check",
-			"Could not read check ID "+config.ID.ValueString()+": "+err.Error(),
-		)
-		return
+	var check *client.Check
+	if !config.ID.IsNull() {
+		var err error
+		check, err = d.client.GetCheck(ctx, config.ID.ValueString())
+		if err != nil {
+			var notFound *client.NotFoundError
+			if errors.As(err, &notFound) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("id"),
+					"No Matching Check",
+					"No check has ID "+config.ID.ValueString()+".",
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Check",
+				"Could not read check ID "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		check = d.findCheck(ctx, &config, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	config.CustomerID = types.StringValue(check.CustomerID)
-	config.Type = types.StringValue(check.Type)
-	config.Target = types.StringValue(check.Parameters.Target)
-	config.Label = types.StringValue(check.Label)
-	config.Enabled = types.BoolValue(check.Enabled == "active")
-	config.Public = types.BoolValue(check.Public)
+	lookupTags := config.Tags
+	model := checkresource.MapCheck(ctx, check)
+
+	config = CheckDataSourceModel{
+		ID:             model.ID,
+		CustomerID:     model.CustomerID,
+		Type:           model.Type,
+		Target:         model.Target,
+		Label:          model.Label,
+		Enabled:        model.Enabled,
+		Public:         model.Public,
+		Interval:       model.Interval,
+		Threshold:      model.Threshold,
+		Sens:           model.Sens,
+		State:          model.State,
+		Created:        model.Created,
+		Modified:       model.Modified,
+		Description:    model.Description,
+		Tags:           model.Tags,
+		Mute:           model.Mute,
+		Dep:            types.StringNull(),
+		RunLocations:   model.RunLocations,
+		HomeLoc:        model.HomeLoc,
+		AutoDiag:       model.AutoDiag,
+		Notifications:  model.Notifications,
+		ContentString:  model.ContentString,
+		Regex:          model.Regex,
+		Invert:         model.Invert,
+		Follow:         model.Follow,
+		Method:         model.Method,
+		StatusCode:     model.StatusCode,
+		SendHeaders:    model.SendHeaders,
+		ReceiveHeaders: model.ReceiveHeaders,
+		PostData:       model.PostData,
+		Port:           model.Port,
+		Username:       model.Username,
+		Secure:         model.Secure,
+		Verify:         model.Verify,
+		IPv6:           model.IPv6,
+		DNSType:        model.DNSType,
+		DNSToResolve:   model.DNSToResolve,
+		DNSSection:     model.DNSSection,
+		DNSRD:          model.DNSRD,
+		Transport:      model.Transport,
+		WarningDays:    model.WarningDays,
+		ServerName:     model.ServerName,
+		Email:          model.Email,
+		Database:       model.Database,
+		Query:          model.Query,
+		Namespace:      model.Namespace,
+		SSHKey:         model.SSHKey,
+		ClientCert:     model.ClientCert,
+		SNMPv:          model.SNMPv,
 
-	if interval, err := check.Interval.Float64(); err == nil {
-		config.Interval = types.Float64Value(interval)
+		ExtraParametersJSON: model.ExtraParametersJSON,
 	}
 
-	if threshold, ok := check.Parameters.Threshold.(float64); ok {
-		config.Threshold = types.Int64Value(int64(threshold))
+	if dep := check.DepID(); dep != "" {
+		config.Dep = types.StringValue(dep)
 	}
 
-	if sens, ok := check.Parameters.Sens.(float64); ok {
-		config.Sens = types.Int64Value(int64(sens))
+	// Terraform requires configured values to be kept
+	if !lookupTags.IsNull() {
+		config.Tags = lookupTags
 	}
 
-	config.State = types.Int64Value(int64(check.State))
-	config.Created = types.Int64Value(check.Created)
-	config.Modified = types.Int64Value(check.Modified)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// findCheck looks the check up by label, target or tags, and fails unless
+// exactly one check matches.
+func (d *CheckDataSource) findCheck(ctx context.Context, config *CheckDataSourceModel, resp *datasource.ReadResponse) *client.Check {
+	var terms []client.CheckFilterTerm
+	var lookup string
+	var lookupPath path.Path
 
-	if check.Description != "" {
-		config.Description = types.StringValue(check.Description)
-	} else {
-		config.Description = types.StringNull()
+	switch {
+	case !config.Label.IsNull():
+		terms = append(terms, client.CheckFilterTerm{Name: client.CheckFilterLabel, Values: []string{config.Label.ValueString()}})
+		lookup = fmt.Sprintf("label %q", config.Label.ValueString())
+		lookupPath = path.Root("label")
+	case !config.Target.IsNull():
+		terms = append(terms, client.CheckFilterTerm{Name: client.CheckFilterTarget, Values: []string{config.Target.ValueString()}})
+		lookup = fmt.Sprintf("target %q", config.Target.ValueString())
+		lookupPath = path.Root("target")
+	default:
+		var tags []string
+		resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+		if len(tags) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tags"),
+				"Invalid Check Lookup",
+				"tags must list at least one tag.",
+			)
+			return nil
+		}
+		for _, tag := range tags {
+			terms = append(terms, client.CheckFilterTerm{Name: client.CheckFilterTag, Values: []string{tag}})
+		}
+		lookup = "tags " + strings.Join(tags, ", ")
+		lookupPath = path.Root("tags")
 	}
 
-	if check.Tags != nil {
-		tags, _ := types.ListValueFrom(ctx, types.StringType, check.Tags)
-		config.Tags = tags
-	} else {
-		config.Tags = types.ListNull(types.StringType)
+	filter, err := client.NewCheckFilter(terms)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(lookupPath, "Invalid Check Lookup", err.Error())
+		return nil
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	checks, err := d.client.ListChecks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Checks",
+			"Could not list checks: "+err.Error(),
+		)
+		return nil
+	}
+
+	matched := filter.Apply(checks)
+	switch len(matched) {
+	case 0:
+		resp.Diagnostics.AddAttributeError(
+			lookupPath,
+			"No Matching Check",
+			"No check matches "+lookup+".",
+		)
+		return nil
+	case 1:
+		return &matched[0]
+	}
+
+	found := make([]string, 0, len(matched))
+	for _, check := range matched {
+		found = append(found, fmt.Sprintf("%s (%s)", check.ID, check.Label))
+	}
+	resp.Diagnostics.AddAttributeError(
+		lookupPath,
+		"Multiple Matching Checks",
+		fmt.Sprintf("%d checks match %s: %s. Narrow the lookup or use id instead.", len(matched), lookup, strings.Join(found, ", ")),
+	)
+	return nil
 }
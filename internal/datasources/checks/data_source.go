@@ -3,9 +3,14 @@ package checks
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -15,25 +20,58 @@ import (
 var _ datasource.DataSource = &ChecksDataSource{}
 var _ datasource.DataSourceWithConfigure = &ChecksDataSource{}
 
+const (
+	tagsMatchAny = "any"
+	tagsMatchAll = "all"
+)
+
 type ChecksDataSource struct {
 	client *client.Client
 }
 
 type ChecksDataSourceModel struct {
-	Type   types.String `tfsdk:"type"`
-	Checks []CheckModel `tfsdk:"checks"`
+	Type           types.String          `tfsdk:"type"`
+	Tags           types.List            `tfsdk:"tags"`
+	TagsMatch      types.String          `tfsdk:"tags_match"`
+	LabelRegex     types.String          `tfsdk:"label_regex"`
+	TargetContains types.String          `tfsdk:"target_contains"`
+	Enabled        types.Bool            `tfsdk:"enabled"`
+	State          types.Int64           `tfsdk:"state"`
+	RunLocation    types.String          `tfsdk:"runlocation"`
+	CustomerID     types.String          `tfsdk:"customer_id"`
+	AllSubaccounts types.Bool            `tfsdk:"all_subaccounts"`
+	Filters        []FilterModel         `tfsdk:"filter"`
+	Checks         []CheckModel          `tfsdk:"checks"`
+	ChecksByLabel  map[string]CheckModel `tfsdk:"checks_by_label"`
+}
+
+type FilterModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
 }
 
 type CheckModel struct {
-	ID         types.String  `tfsdk:"id"`
-	CustomerID types.String  `tfsdk:"customer_id"`
-	Type       types.String  `tfsdk:"type"`
-	Target     types.String  `tfsdk:"target"`
-	Label      types.String  `tfsdk:"label"`
-	Enabled    types.Bool    `tfsdk:"enabled"`
-	Interval   types.Float64 `tfsdk:"interval"`
-	State      types.Int64   `tfsdk:"state"`
-	Tags       types.List    `tfsdk:"tags"`
+	ID            types.String        `tfsdk:"id"`
+	CustomerID    types.String        `tfsdk:"customer_id"`
+	Type          types.String        `tfsdk:"type"`
+	Target        types.String        `tfsdk:"target"`
+	Label         types.String        `tfsdk:"label"`
+	Enabled       types.Bool          `tfsdk:"enabled"`
+	Interval      types.Float64       `tfsdk:"interval"`
+	State         types.Int64         `tfsdk:"state"`
+	Tags          types.List          `tfsdk:"tags"`
+	Description   types.String        `tfsdk:"description"`
+	RunLocations  types.List          `tfsdk:"runlocations"`
+	Threshold     types.Int64         `tfsdk:"threshold"`
+	Sens          types.Int64         `tfsdk:"sens"`
+	Dep           types.String        `tfsdk:"dep"`
+	Notifications []NotificationModel `tfsdk:"notifications"`
+}
+
+type NotificationModel struct {
+	ContactID types.String `tfsdk:"contact_id"`
+	Delay     types.Int64  `tfsdk:"delay"`
+	Schedule  types.String `tfsdk:"schedule"`
 }
 
 func NewChecksDataSource() datasource.DataSource {
@@ -48,7 +86,7 @@ func (d *ChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Fetches all NodePing checks with optional filtering.",
 		MarkdownDescription: `
-Fetches all NodePing checks with optional filtering.
+Fetches all NodePing checks with optional filtering. All filters must match for a check to be returned.
 
 ## Example Usage
 
@@ -59,9 +97,23 @@ data "nodeping_checks" "http_only" {
   type = "HTTP"
 }
 
+data "nodeping_checks" "prod_api" {
+  tags       = ["prod", "api"]
+  tags_match = "all"
+
+  filter {
+    name   = "runlocation"
+    values = ["nam", "eur"]
+  }
+}
+
 output "check_ids" {
   value = [for c in data.nodeping_checks.all.checks : c.id]
 }
+
+output "api_check_id" {
+  value = data.nodeping_checks.prod_api.checks_by_label["api.example.com"].id
+}
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
@@ -69,46 +121,76 @@ output "check_ids" {
 				Description: "Filter checks by type.",
 				Optional:    true,
 			},
+			"tags": schema.ListAttribute{
+				Description: "Filter checks by tag. See tags_match.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags_match": schema.StringAttribute{
+				Description: "Whether checks must carry any (the default) or all of tags.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tagsMatchAny, tagsMatchAll),
+				},
+			},
+			"label_regex": schema.StringAttribute{
+				Description: "Filter checks by a regular expression (Go syntax) matched against the label.",
+				Optional:    true,
+			},
+			"target_contains": schema.StringAttribute{
+				Description: "Filter checks whose target contains this string.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Filter checks by whether they are enabled.",
+				Optional:    true,
+			},
+			"state": schema.Int64Attribute{
+				Description: "Filter checks by current state (0 = failing, 1 = passing).",
+				Optional:    true,
+			},
+			"runlocation": schema.StringAttribute{
+				Description: "Filter checks that list this region or probe in runlocations.",
+				Optional:    true,
+			},
+			"customer_id": schema.StringAttribute{
+				Description: "Filter checks by the customer ID (account ID) that owns them.",
+				Optional:    true,
+			},
+			"all_subaccounts": schema.BoolAttribute{
+				Description: "List the checks of the provider's account and all of its SubAccounts. SubAccounts that cannot be read are reported as warnings. Defaults to false.",
+				Optional:    true,
+			},
 			"checks": schema.ListNestedAttribute{
-				Description: "List of checks.",
+				Description: "List of checks, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: checkAttributes(),
+				},
+			},
+			"checks_by_label": schema.MapNestedAttribute{
+				Description: "The checks keyed by label. If several checks share a label, the one with the lowest ID is used.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
+					Attributes: checkAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.ListNestedBlock{
+				Description: "Generic filter. A check matches a filter if its field matches any of values; it must match every filter. Valid names: " + strings.Join(client.CheckFilterNames, ", ") + ".",
+				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "The unique identifier of the check.",
-							Computed:    true,
-						},
-						"customer_id": schema.StringAttribute{
-							Description: "The customer ID (account ID) that owns this check.",
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "The type of check.",
-							Computed:    true,
-						},
-						"target": schema.StringAttribute{
-							Description: "The target URL, hostname, or IP address.",
-							Computed:    true,
-						},
-						"label": schema.StringAttribute{
-							Description: "The label for the check.",
-							Computed:    true,
-						},
-						"enabled": schema.BoolAttribute{
-							Description: "Whether the check is enabled.",
-							Computed:    true,
-						},
-						"interval": schema.Float64Attribute{
-							Description: "Check interval in minutes.",
-							Computed:    true,
-						},
-						"state": schema.Int64Attribute{
-							Description: "Current state (0 = failing, 1 = passing).",
-							Computed:    true,
+						"name": schema.StringAttribute{
+							Description: "The field to filter on.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.CheckFilterNames...),
+							},
 						},
-						"tags": schema.ListAttribute{
-							Description: "Tags for the check.",
-							Computed:    true,
+						"values": schema.ListAttribute{
+							Description: "Values to match. label_regex values are regular expressions and target_contains values are substrings.",
+							Required:    true,
 							ElementType: types.StringType,
 						},
 					},
@@ -118,6 +200,89 @@ output "check_ids" {
 	}
 }
 
+func checkAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the check.",
+			Computed:    true,
+		},
+		"customer_id": schema.StringAttribute{
+			Description: "The customer ID (account ID) that owns this check.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of check.",
+			Computed:    true,
+		},
+		"target": schema.StringAttribute{
+			Description: "The target URL, hostname, or IP address.",
+			Computed:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: "The label for the check.",
+			Computed:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Whether the check is enabled.",
+			Computed:    true,
+		},
+		"interval": schema.Float64Attribute{
+			Description: "Check interval in minutes.",
+			Computed:    true,
+		},
+		"state": schema.Int64Attribute{
+			Description: "Current state (0 = failing, 1 = passing).",
+			Computed:    true,
+		},
+		"tags": schema.ListAttribute{
+			Description: "Tags for the check.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the check.",
+			Computed:    true,
+		},
+		"runlocations": schema.ListAttribute{
+			Description: "Regions or probes the check runs from.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"threshold": schema.Int64Attribute{
+			Description: "Timeout in seconds.",
+			Computed:    true,
+		},
+		"sens": schema.Int64Attribute{
+			Description: "Number of rechecks before a state change is reported.",
+			Computed:    true,
+		},
+		"dep": schema.StringAttribute{
+			Description: "The ID of the check this check depends on.",
+			Computed:    true,
+		},
+		"notifications": schema.ListNestedAttribute{
+			Description: "Notifications for the check.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"contact_id": schema.StringAttribute{
+						Description: "The contact address ID.",
+						Computed:    true,
+					},
+					"delay": schema.Int64Attribute{
+						Description: "Minutes to wait before notifying.",
+						Computed:    true,
+					},
+					"schedule": schema.StringAttribute{
+						Description: "The notification schedule.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
 func (d *ChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -142,9 +307,37 @@ func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	tflog.Debug(ctx, "Reading checks data source")
+	terms := d.filterTerms(ctx, &config, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	checks, err := d.client.ListChecks(ctx)
+	filter, err := client.NewCheckFilter(terms)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Check Filter",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading checks data source", map[string]interface{}{
+		"filters": len(terms),
+	})
+
+	var checks map[string]client.Check
+	if config.AllSubaccounts.ValueBool() {
+		var failures []client.AccountFailure
+		checks, failures, err = d.client.ListChecksAllAccounts(ctx)
+		for _, failure := range failures {
+			resp.Diagnostics.AddWarning(
+				"Error Reading SubAccount Checks",
+				"Could not list the checks of "+failure.Account()+", so they are missing from the result: "+failure.Err.Error(),
+			)
+		}
+	} else {
+		checks, err = d.client.ListChecks(ctx)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Checks",
@@ -153,37 +346,142 @@ func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	typeFilter := config.Type.ValueString()
+	matched := filter.Apply(checks)
+
+	config.Checks = make([]CheckModel, 0, len(matched))
+	config.ChecksByLabel = make(map[string]CheckModel, len(matched))
+	var duplicates []string
+	for i := range matched {
+		checkModel := mapCheck(ctx, &matched[i])
+		config.Checks = append(config.Checks, checkModel)
 
-	config.Checks = make([]CheckModel, 0, len(checks))
-	for _, check := range checks {
-		if typeFilter != "" && check.Type != typeFilter {
+		label := matched[i].Label
+		if _, ok := config.ChecksByLabel[label]; ok {
+			duplicates = append(duplicates, label)
 			continue
 		}
+		config.ChecksByLabel[label] = checkModel
+	}
 
-		checkModel := CheckModel{
-			ID:         types.StringValue(check.ID),
-			CustomerID: types.StringValue(check.CustomerID),
-			Type:       types.StringValue(check.Type),
-			Target:     types.StringValue(check.Parameters.Target),
-			Label:      types.StringValue(check.Label),
-			Enabled:    types.BoolValue(check.Enabled == "active"),
-			State:      types.Int64Value(int64(check.State)),
-		}
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate Check Labels",
+			"Several checks share the labels "+strings.Join(duplicates, ", ")+". "+
+				"checks_by_label holds the check with the lowest ID for each; use the checks list to see them all.",
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// filterTerms turns the convenience attributes and filter blocks into
+// client filter terms.
+func (d *ChecksDataSource) filterTerms(ctx context.Context, config *ChecksDataSourceModel, resp *datasource.ReadResponse) []client.CheckFilterTerm {
+	var terms []client.CheckFilterTerm
+	add := func(name string, values ...string) {
+		terms = append(terms, client.CheckFilterTerm{Name: name, Values: values})
+	}
 
-		if interval, err := check.Interval.Float64(); err == nil {
-			checkModel.Interval = types.Float64Value(interval)
+	if !config.Type.IsNull() {
+		add(client.CheckFilterType, config.Type.ValueString())
+	}
+	if !config.Tags.IsNull() {
+		var tags []string
+		resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if config.TagsMatch.ValueString() == tagsMatchAll {
+			for _, tag := range tags {
+				add(client.CheckFilterTag, tag)
+			}
+		} else if len(tags) > 0 {
+			add(client.CheckFilterTag, tags...)
 		}
+	}
+	if !config.LabelRegex.IsNull() {
+		add(client.CheckFilterLabelRegex, config.LabelRegex.ValueString())
+	}
+	if !config.TargetContains.IsNull() {
+		add(client.CheckFilterTargetContains, config.TargetContains.ValueString())
+	}
+	if !config.Enabled.IsNull() {
+		add(client.CheckFilterEnabled, strconv.FormatBool(config.Enabled.ValueBool()))
+	}
+	if !config.State.IsNull() {
+		add(client.CheckFilterState, strconv.FormatInt(config.State.ValueInt64(), 10))
+	}
+	if !config.RunLocation.IsNull() {
+		add(client.CheckFilterRunLocation, config.RunLocation.ValueString())
+	}
+	if !config.CustomerID.IsNull() {
+		add(client.CheckFilterCustomerID, config.CustomerID.ValueString())
+	}
 
-		if check.Tags != nil {
-			tags, _ := types.ListValueFrom(ctx, types.StringType, check.Tags)
-			checkModel.Tags = tags
-		} else {
-			checkModel.Tags = types.ListNull(types.StringType)
+	for i, f := range config.Filters {
+		var values []string
+		resp.Diagnostics.Append(f.Values.ElementsAs(ctx, &values, false)...)
+		if len(values) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("filter").AtListIndex(i).AtName("values"),
+				"Invalid Check Filter",
+				"A filter needs at least one value.",
+			)
+			continue
 		}
+		add(f.Name.ValueString(), values...)
+	}
 
-		config.Checks = append(config.Checks, checkModel)
+	return terms
+}
+
+func mapCheck(ctx context.Context, check *client.Check) CheckModel {
+	checkModel := CheckModel{
+		ID:           types.StringValue(check.ID),
+		CustomerID:   types.StringValue(check.CustomerID),
+		Type:         types.StringValue(check.Type),
+		Target:       types.StringValue(check.Parameters.Target),
+		Label:        types.StringValue(check.Label),
+		Enabled:      types.BoolValue(check.Enabled == "active"),
+		State:        types.Int64Value(int64(check.State)),
+		Description:  types.StringValue(check.Description),
+		Threshold:    types.Int64Null(),
+		Sens:         types.Int64Null(),
+		Dep:          types.StringNull(),
+		RunLocations: types.ListNull(types.StringType),
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	if interval, err := check.Interval.Float64(); err == nil {
+		checkModel.Interval = types.Float64Value(interval)
+	}
+
+	if check.Tags != nil {
+		tags, _ := types.ListValueFrom(ctx, types.StringType, check.Tags)
+		checkModel.Tags = tags
+	} else {
+		checkModel.Tags = types.ListNull(types.StringType)
+	}
+
+	if locations := check.RunLocationList(); locations != nil {
+		runLocations, _ := types.ListValueFrom(ctx, types.StringType, locations)
+		checkModel.RunLocations = runLocations
+	}
+
+	if threshold, ok := check.ThresholdValue(); ok {
+		checkModel.Threshold = types.Int64Value(threshold)
+	}
+	if sens, ok := check.SensValue(); ok {
+		checkModel.Sens = types.Int64Value(sens)
+	}
+	if dep := check.DepID(); dep != "" {
+		checkModel.Dep = types.StringValue(dep)
+	}
+
+	checkModel.Notifications = make([]NotificationModel, 0)
+	for _, n := range check.NotificationList() {
+		checkModel.Notifications = append(checkModel.Notifications, NotificationModel{
+			ContactID: types.StringValue(n.ContactID),
+			Delay:     types.Int64Value(n.Delay),
+			Schedule:  types.StringValue(n.Schedule),
+		})
+	}
+
+	return checkModel
 }
@@ -0,0 +1,314 @@
+package checkstates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+// Check states reported by the data source.
+const (
+	statePassing  = "passing"
+	stateFailing  = "failing"
+	stateDisabled = "disabled"
+)
+
+var _ datasource.DataSource = &CheckStatesDataSource{}
+var _ datasource.DataSourceWithConfigure = &CheckStatesDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &CheckStatesDataSource{}
+
+type CheckStatesDataSource struct {
+	client *client.Client
+}
+
+type CheckStatesDataSourceModel struct {
+	CheckIDs          []types.String    `tfsdk:"check_ids"`
+	Tags              []types.String    `tfsdk:"tags"`
+	ErrorIfFailing    types.Bool        `tfsdk:"error_if_failing"`
+	DisabledIsFailing types.Bool        `tfsdk:"disabled_is_failing"`
+	AllPassing        types.Bool        `tfsdk:"all_passing"`
+	FailingCheckIDs   []types.String    `tfsdk:"failing_check_ids"`
+	Checks            []CheckStateModel `tfsdk:"checks"`
+}
+
+type CheckStateModel struct {
+	ID             types.String `tfsdk:"id"`
+	Label          types.String `tfsdk:"label"`
+	Type           types.String `tfsdk:"type"`
+	State          types.String `tfsdk:"state"`
+	FirstDown      types.String `tfsdk:"first_down"`
+	LastResultTime types.String `tfsdk:"last_result_time"`
+	Message        types.String `tfsdk:"message"`
+}
+
+func NewCheckStatesDataSource() datasource.DataSource {
+	return &CheckStatesDataSource{}
+}
+
+func (d *CheckStatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_states"
+}
+
+func (d *CheckStatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current state of a set of checks, for use as a release gate.",
+		MarkdownDescription: `
+Fetches the current state of a set of checks, when each started failing and its last result message. Use it as a release gate: with ` + "`error_if_failing = true`" + `, ` + "`terraform plan`" + ` fails while any of the checks is failing.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_check_states" "dependencies" {
+  tags             = ["payments-dependency"]
+  error_if_failing = true
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"check_ids": schema.ListAttribute{
+				Description: "IDs of the checks to fetch. Conflicts with tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "Fetch the checks carrying any of these tags. Conflicts with check_ids.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"error_if_failing": schema.BoolAttribute{
+				Description: "Report an error, failing the plan, while any of the checks is failing. Defaults to false.",
+				Optional:    true,
+			},
+			"disabled_is_failing": schema.BoolAttribute{
+				Description: "Count disabled checks as failing, so a gate cannot pass while a check it relies on is switched off. Defaults to false: disabling a check, for example during maintenance, does not block the gate.",
+				Optional:    true,
+			},
+			"all_passing": schema.BoolAttribute{
+				Description: "Whether none of the checks is failing. Disabled checks count as failing only with disabled_is_failing.",
+				Computed:    true,
+			},
+			"failing_check_ids": schema.ListAttribute{
+				Description: "IDs of the failing checks, sorted. Includes disabled checks with disabled_is_failing.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "The checks, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The check ID.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The check label.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The check type.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The current state: passing, failing or disabled.",
+							Computed:    true,
+						},
+						"first_down": schema.StringAttribute{
+							Description: "When the current failure began, in RFC 3339 format. Null unless failing.",
+							Computed:    true,
+						},
+						"last_result_time": schema.StringAttribute{
+							Description: "When the last result started, in RFC 3339 format. Null if the check has not run.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message of the last result. Null if the check has not run or the result had none.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CheckStatesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("check_ids"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (d *CheckStatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CheckStatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CheckStatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading check states data source", map[string]interface{}{
+		"check_ids": len(config.CheckIDs),
+		"tags":      len(config.Tags),
+	})
+
+	checks := d.selectChecks(ctx, config, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Checks = make([]CheckStateModel, 0, len(checks))
+	config.FailingCheckIDs = []types.String{}
+	var failing []string
+	for _, check := range checks {
+		model := CheckStateModel{
+			ID:             types.StringValue(check.ID),
+			Label:          types.StringValue(check.Label),
+			Type:           types.StringValue(check.Type),
+			FirstDown:      types.StringNull(),
+			LastResultTime: types.StringNull(),
+			Message:        types.StringNull(),
+		}
+
+		switch {
+		case check.Enabled != "active":
+			model.State = types.StringValue(stateDisabled)
+		case check.State == 0:
+			model.State = types.StringValue(stateFailing)
+			if firstDown, ok := check.FirstDownTime(); ok {
+				model.FirstDown = types.StringValue(formatMillis(firstDown))
+			}
+		default:
+			model.State = types.StringValue(statePassing)
+		}
+
+		results, err := d.client.GetCheckResults(ctx, check.ID, 1)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Check Results",
+				"Could not read results for check ID "+check.ID+": "+err.Error(),
+			)
+			return
+		}
+		if len(results) > 0 {
+			model.LastResultTime = types.StringValue(formatMillis(results[0].Start))
+			if results[0].Message != "" {
+				model.Message = types.StringValue(results[0].Message)
+			}
+		}
+
+		if model.State.ValueString() == stateFailing || (model.State.ValueString() == stateDisabled && config.DisabledIsFailing.ValueBool()) {
+			config.FailingCheckIDs = append(config.FailingCheckIDs, model.ID)
+			failing = append(failing, describeFailure(check, model))
+		}
+		config.Checks = append(config.Checks, model)
+	}
+	config.AllPassing = types.BoolValue(len(failing) == 0)
+
+	if config.ErrorIfFailing.ValueBool() && len(failing) > 0 {
+		resp.Diagnostics.AddError(
+			"Checks Failing",
+			fmt.Sprintf("%d of %d checks are failing:\n\n%s\n\nSet error_if_failing = false to read their states without failing.", len(failing), len(checks), strings.Join(failing, "\n")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// selectChecks returns the checks named by check_ids or carrying any of
+// tags, sorted by ID.
+func (d *CheckStatesDataSource) selectChecks(ctx context.Context, config CheckStatesDataSourceModel, resp *datasource.ReadResponse) []client.Check {
+	all, err := d.client.ListChecks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Checks",
+			"Could not read checks: "+err.Error(),
+		)
+		return nil
+	}
+
+	tags := make([]string, 0, len(config.Tags))
+	for _, tag := range config.Tags {
+		tags = append(tags, tag.ValueString())
+	}
+	ids := make([]string, 0, len(config.CheckIDs))
+	for _, id := range config.CheckIDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	checks, missing, err := client.SelectChecks(all, ids, tags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tags"), "Invalid Tags", err.Error())
+		return nil
+	}
+	for _, id := range missing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_ids"),
+			"No Matching Check",
+			"No check found with ID "+id+".",
+		)
+	}
+	if len(tags) > 0 && len(checks) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"No Matching Checks",
+			"No checks carry any of the tags "+strings.Join(tags, ", ")+".",
+		)
+	}
+	return checks
+}
+
+func describeFailure(check client.Check, model CheckStateModel) string {
+	line := fmt.Sprintf("  - %s (%s)", check.ID, check.Label)
+	if model.State.ValueString() == stateDisabled {
+		return line + " is disabled"
+	}
+	if !model.FirstDown.IsNull() {
+		line += " failing since " + model.FirstDown.ValueString()
+	}
+	if !model.Message.IsNull() {
+		line += ": " + model.Message.ValueString()
+	}
+	return line
+}
+
+func formatMillis(ms int64) string {
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
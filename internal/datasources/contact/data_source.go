@@ -2,10 +2,16 @@ package contact
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -14,6 +20,7 @@ import (
 
 var _ datasource.DataSource = &ContactDataSource{}
 var _ datasource.DataSourceWithConfigure = &ContactDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ContactDataSource{}
 
 type ContactDataSource struct {
 	client *client.Client
@@ -31,11 +38,19 @@ type AddressDataSourceModel struct {
 	ID            types.String `tfsdk:"id"`
 	Type          types.String `tfsdk:"type"`
 	Address       types.String `tfsdk:"address"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
 	SuppressUp    types.Bool   `tfsdk:"suppress_up"`
 	SuppressDown  types.Bool   `tfsdk:"suppress_down"`
 	SuppressFirst types.Bool   `tfsdk:"suppress_first"`
 	SuppressDiag  types.Bool   `tfsdk:"suppress_diag"`
 	SuppressAll   types.Bool   `tfsdk:"suppress_all"`
+	Mute          types.Bool   `tfsdk:"mute"`
+	Status        types.String `tfsdk:"status"`
+	Action        types.String `tfsdk:"action"`
+	Headers       types.Map    `tfsdk:"headers"`
+	QueryStrings  types.Map    `tfsdk:"querystrings"`
+	Data          types.String `tfsdk:"data"`
+	Priority      types.Int64  `tfsdk:"priority"`
 }
 
 func NewContactDataSource() datasource.DataSource {
@@ -48,9 +63,9 @@ func (d *ContactDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *ContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a NodePing contact by ID.",
+		Description: "Fetches a NodePing contact by ID or name.",
 		MarkdownDescription: `
-Fetches a NodePing contact by ID.
+Fetches a NodePing contact by ID or name. Exactly one of these must be set, and a name must match exactly one contact.
 
 ## Example Usage
 
@@ -59,6 +74,10 @@ data "nodeping_contact" "example" {
   id = "201205050153W2Q4C-BKPGH"
 }
 
+data "nodeping_contact" "devops" {
+  name = "DevOps Team"
+}
+
 output "contact_name" {
   value = data.nodeping_contact.example.name
 }
@@ -66,15 +85,17 @@ output "contact_name" {
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The unique identifier of the contact.",
-				Required:    true,
+				Description: "The unique identifier of the contact. Conflicts with name.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"customer_id": schema.StringAttribute{
 				Description: "The customer ID (account ID) that owns this contact.",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the contact.",
+				Description: "The name of the contact. Set it to look the contact up by exact name.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"custrole": schema.StringAttribute{
@@ -85,47 +106,99 @@ output "contact_name" {
 				Description: "Contact addresses for receiving notifications.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "The unique identifier of the address.",
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "The type of address.",
-							Computed:    true,
-						},
-						"address": schema.StringAttribute{
-							Description: "The address value.",
-							Computed:    true,
-							Sensitive:   true,
-						},
-						"suppress_up": schema.BoolAttribute{
-							Description: "Suppress 'up' notifications.",
-							Computed:    true,
-						},
-						"suppress_down": schema.BoolAttribute{
-							Description: "Suppress 'down' notifications.",
-							Computed:    true,
-						},
-						"suppress_first": schema.BoolAttribute{
-							Description: "Suppress 'first result' notifications.",
-							Computed:    true,
-						},
-						"suppress_diag": schema.BoolAttribute{
-							Description: "Suppress diagnostic notifications.",
-							Computed:    true,
-						},
-						"suppress_all": schema.BoolAttribute{
-							Description: "Suppress all notifications.",
-							Computed:    true,
-						},
-					},
+					Attributes: AddressAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// AddressAttributes returns the schema of a contact address, shared with
+// the nodeping_contacts data source.
+func AddressAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the address.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of address.",
+			Computed:    true,
+		},
+		"address": schema.StringAttribute{
+			Description: "The address value. Redacted when the provider's mask_pii is set.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"fingerprint": schema.StringAttribute{
+			Description: "HMAC-SHA256 fingerprint of the address value, for equality checks without exposing it. Keyed with the provider's pii_fingerprint_key, so it changes when the key does. Null when the key is not set.",
+			Computed:    true,
+		},
+		"suppress_up": schema.BoolAttribute{
+			Description: "Suppress 'up' notifications.",
+			Computed:    true,
+		},
+		"suppress_down": schema.BoolAttribute{
+			Description: "Suppress 'down' notifications.",
+			Computed:    true,
+		},
+		"suppress_first": schema.BoolAttribute{
+			Description: "Suppress 'first result' notifications.",
+			Computed:    true,
+		},
+		"suppress_diag": schema.BoolAttribute{
+			Description: "Suppress diagnostic notifications.",
+			Computed:    true,
+		},
+		"suppress_all": schema.BoolAttribute{
+			Description: "Suppress all notifications.",
+			Computed:    true,
+		},
+		"mute": schema.BoolAttribute{
+			Description: "Whether notifications to this address are muted.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the address as reported by the API.",
+			Computed:    true,
+		},
+		"action": schema.StringAttribute{
+			Description: "HTTP method for webhook addresses.",
+			Computed:    true,
+		},
+		"headers": schema.MapAttribute{
+			Description: "HTTP headers for webhook addresses.",
+			Computed:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+		},
+		"querystrings": schema.MapAttribute{
+			Description: "Query string parameters for webhook addresses.",
+			Computed:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+		},
+		"data": schema.StringAttribute{
+			Description: "Request body for webhook addresses.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"priority": schema.Int64Attribute{
+			Description: "Priority for Pushover addresses.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *ContactDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *ContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -151,35 +224,151 @@ func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	tflog.Debug(ctx, "Reading contact data source", map[string]interface{}{
-		"id": config.ID.ValueString(),
+		"id":   config.ID.ValueString(),
+		"name": config.Name.ValueString(),
 	})
 
-	contact, err := d.client.GetContact(ctx, config.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Contact",
-			"Could not read contact ID "+config.ID.ValueString()+": "+err.Error(),
-		)
-		return
+	var contact *client.Contact
+	if !config.ID.IsNull() {
+		var err error
+		contact, err = d.client.GetContact(ctx, config.ID.ValueString())
+		if err != nil {
+			var notFound *client.NotFoundError
+			if errors.As(err, &notFound) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("id"),
+					"No Matching Contact",
+					"No contact has ID "+config.ID.ValueString()+".",
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Contact",
+				"Could not read contact ID "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		contacts, err := d.client.ListContacts(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Contacts",
+				"Could not list contacts: "+err.Error(),
+			)
+			return
+		}
+
+		contact = FindContactByName(contacts, config.Name.ValueString(), path.Root("name"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
+	config.ID = types.StringValue(contact.ID)
 	config.CustomerID = types.StringValue(contact.CustomerID)
 	config.Name = types.StringValue(contact.Name)
 	config.CustRole = types.StringValue(contact.CustRole)
 
-	config.Addresses = make([]AddressDataSourceModel, 0, len(contact.Addresses))
-	for id, addr := range contact.Addresses {
-		config.Addresses = append(config.Addresses, AddressDataSourceModel{
-			ID:            types.StringValue(id),
-			Type:          types.StringValue(addr.Type),
-			Address:       types.StringValue(addr.Address),
-			SuppressUp:    types.BoolValue(addr.SuppressUp),
-			SuppressDown:  types.BoolValue(addr.SuppressDown),
-			SuppressFirst: types.BoolValue(addr.SuppressFirst),
-			SuppressDiag:  types.BoolValue(addr.SuppressDiag),
-			SuppressAll:   types.BoolValue(addr.SuppressAll),
-		})
-	}
+	config.Addresses = MapAddresses(ctx, d.client, contact)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
+
+// MapAddresses converts the contact's addresses, sorted by ID.
+func MapAddresses(ctx context.Context, c *client.Client, contact *client.Contact) []AddressDataSourceModel {
+	addresses := make([]AddressDataSourceModel, 0, len(contact.Addresses))
+	for _, id := range contact.AddressIDs() {
+		addresses = append(addresses, MapAddress(ctx, c, id, contact.Addresses[id]))
+	}
+	return addresses
+}
+
+// MapAddress converts one API address, masking its value if the client's
+// mask_pii is set.
+func MapAddress(ctx context.Context, c *client.Client, id string, addr client.ContactAddress) AddressDataSourceModel {
+	return AddressDataSourceModel{
+		ID:            types.StringValue(id),
+		Type:          types.StringValue(addr.Type),
+		Address:       types.StringValue(c.PresentAddress(addr.Address)),
+		Fingerprint:   fingerprintValue(c, addr.Address),
+		SuppressUp:    types.BoolValue(addr.SuppressUp),
+		SuppressDown:  types.BoolValue(addr.SuppressDown),
+		SuppressFirst: types.BoolValue(addr.SuppressFirst),
+		SuppressDiag:  types.BoolValue(addr.SuppressDiag),
+		SuppressAll:   types.BoolValue(addr.SuppressAll),
+		Mute:          types.BoolValue(addr.Muted()),
+		Status:        optionalString(addr.Status),
+		Action:        optionalString(addr.Action),
+		Headers:       optionalMap(ctx, addr.Headers),
+		QueryStrings:  optionalMap(ctx, addr.QueryStrings),
+		Data:          optionalString(addr.DataString()),
+		Priority:      optionalPriority(addr.Priority),
+	}
+}
+
+// FindContactByName returns the one contact named name, adding an error
+// on attrPath when none or several are.
+func FindContactByName(contacts map[string]client.Contact, name string, attrPath path.Path, diags *diag.Diagnostics) *client.Contact {
+	var matched []client.Contact
+	for _, contact := range contacts {
+		if contact.Name == name {
+			matched = append(matched, contact)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		diags.AddAttributeError(
+			attrPath,
+			"No Matching Contact",
+			fmt.Sprintf("No contact is named %q.", name),
+		)
+		return nil
+	case 1:
+		return &matched[0]
+	}
+
+	ids := make([]string, 0, len(matched))
+	for _, contact := range matched {
+		ids = append(ids, contact.ID)
+	}
+	sort.Strings(ids)
+	diags.AddAttributeError(
+		attrPath,
+		"Multiple Matching Contacts",
+		fmt.Sprintf("%d contacts are named %q: %s. Use the contact ID instead.", len(matched), name, strings.Join(ids, ", ")),
+	)
+	return nil
+}
+
+// fingerprintValue returns the address fingerprint, or null when the
+// provider has no pii_fingerprint_key.
+func fingerprintValue(c *client.Client, address string) types.String {
+	fingerprint, ok := c.AddressFingerprint(address)
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(fingerprint)
+}
+
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func optionalMap(ctx context.Context, m map[string]string) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	value, _ := types.MapValueFrom(ctx, types.StringType, m)
+	return value
+}
+
+func optionalPriority(priority *int) types.Int64 {
+	if priority == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*priority))
+}
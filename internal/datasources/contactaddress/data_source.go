@@ -0,0 +1,264 @@
+package contactaddress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/contact"
+)
+
+var _ datasource.DataSource = &ContactAddressDataSource{}
+var _ datasource.DataSourceWithConfigure = &ContactAddressDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ContactAddressDataSource{}
+
+type ContactAddressDataSource struct {
+	client *client.Client
+}
+
+type ContactAddressDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ContactID     types.String `tfsdk:"contact_id"`
+	ContactName   types.String `tfsdk:"contact_name"`
+	Type          types.String `tfsdk:"type"`
+	Address       types.String `tfsdk:"address"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+	SuppressUp    types.Bool   `tfsdk:"suppress_up"`
+	SuppressDown  types.Bool   `tfsdk:"suppress_down"`
+	SuppressFirst types.Bool   `tfsdk:"suppress_first"`
+	SuppressDiag  types.Bool   `tfsdk:"suppress_diag"`
+	SuppressAll   types.Bool   `tfsdk:"suppress_all"`
+	Mute          types.Bool   `tfsdk:"mute"`
+	Status        types.String `tfsdk:"status"`
+	Action        types.String `tfsdk:"action"`
+	Headers       types.Map    `tfsdk:"headers"`
+	QueryStrings  types.Map    `tfsdk:"querystrings"`
+	Data          types.String `tfsdk:"data"`
+	Priority      types.Int64  `tfsdk:"priority"`
+}
+
+func NewContactAddressDataSource() datasource.DataSource {
+	return &ContactAddressDataSource{}
+}
+
+func (d *ContactAddressDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact_address"
+}
+
+func (d *ContactAddressDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := contact.AddressAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique identifier of the address, as used by notifications.contact_id.",
+		Computed:    true,
+	}
+	attributes["contact_id"] = schema.StringAttribute{
+		Description: "The ID of the contact owning the address. Conflicts with contact_name.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["contact_name"] = schema.StringAttribute{
+		Description: "The exact name of the contact owning the address. Conflicts with contact_id.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: "The type of address, such as email, sms or webhook. Set it to narrow the lookup.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["address"] = schema.StringAttribute{
+		Description: "The address value. Set it to narrow the lookup to an exact address. Redacted when the provider's mask_pii is set, unless set here.",
+		Optional:    true,
+		Computed:    true,
+		Sensitive:   true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single address of a NodePing contact.",
+		MarkdownDescription: `
+Fetches a single address of a NodePing contact, found by contact ID or name and optionally by address type and value. The lookup must match exactly one address. Its ` + "`id`" + ` is what ` + "`notifications.contact_id`" + ` on ` + "`nodeping_check`" + ` expects.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_contact_address" "oncall_sms" {
+  contact_name = "On-Call"
+  type         = "sms"
+}
+
+resource "nodeping_check" "website" {
+  type   = "HTTP"
+  target = "https://example.com"
+
+  notifications {
+    contact_id = data.nodeping_contact_address.oncall_sms.id
+    delay      = 0
+    schedule   = "All"
+  }
+}
+` + "```" + `
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *ContactAddressDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("contact_id"),
+			path.MatchRoot("contact_name"),
+		),
+	}
+}
+
+func (d *ContactAddressDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ContactAddressDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContactAddressDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading contact address data source", map[string]interface{}{
+		"contact_id":   config.ContactID.ValueString(),
+		"contact_name": config.ContactName.ValueString(),
+		"type":         config.Type.ValueString(),
+	})
+
+	var owner *client.Contact
+	if !config.ContactID.IsNull() {
+		var err error
+		owner, err = d.client.GetContact(ctx, config.ContactID.ValueString())
+		if err != nil {
+			var notFound *client.NotFoundError
+			if errors.As(err, &notFound) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("contact_id"),
+					"No Matching Contact",
+					"No contact has ID "+config.ContactID.ValueString()+".",
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Contact",
+				"Could not read contact ID "+config.ContactID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		contacts, err := d.client.ListContacts(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Contacts",
+				"Could not list contacts: "+err.Error(),
+			)
+			return
+		}
+
+		owner = contact.FindContactByName(contacts, config.ContactName.ValueString(), path.Root("contact_name"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var matched []string
+	for _, id := range owner.AddressIDs() {
+		addr := owner.Addresses[id]
+		if !config.Type.IsNull() && addr.Type != config.Type.ValueString() {
+			continue
+		}
+		if !config.Address.IsNull() && addr.Address != config.Address.ValueString() {
+			continue
+		}
+		matched = append(matched, id)
+	}
+
+	switch len(matched) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"No Matching Contact Address",
+			fmt.Sprintf("Contact %s (%s) has no address matching %s.", owner.ID, owner.Name, describeLookup(&config)),
+		)
+		return
+	case 1:
+	default:
+		found := make([]string, 0, len(matched))
+		for _, id := range matched {
+			found = append(found, fmt.Sprintf("%s (%s)", id, owner.Addresses[id].Type))
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Matching Contact Addresses",
+			fmt.Sprintf("Contact %s (%s) has %d addresses matching %s: %s. Set type or address to narrow the lookup.",
+				owner.ID, owner.Name, len(matched), describeLookup(&config), strings.Join(found, ", ")),
+		)
+		return
+	}
+
+	addr := contact.MapAddress(ctx, d.client, matched[0], owner.Addresses[matched[0]])
+	if !config.Address.IsNull() {
+		// The configured value must be kept, and is no secret to the caller
+		addr.Address = config.Address
+	}
+
+	state := ContactAddressDataSourceModel{
+		ID:            addr.ID,
+		ContactID:     types.StringValue(owner.ID),
+		ContactName:   types.StringValue(owner.Name),
+		Type:          addr.Type,
+		Address:       addr.Address,
+		Fingerprint:   addr.Fingerprint,
+		SuppressUp:    addr.SuppressUp,
+		SuppressDown:  addr.SuppressDown,
+		SuppressFirst: addr.SuppressFirst,
+		SuppressDiag:  addr.SuppressDiag,
+		SuppressAll:   addr.SuppressAll,
+		Mute:          addr.Mute,
+		Status:        addr.Status,
+		Action:        addr.Action,
+		Headers:       addr.Headers,
+		QueryStrings:  addr.QueryStrings,
+		Data:          addr.Data,
+		Priority:      addr.Priority,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// describeLookup names the address filters for error messages. The
+// address value is left out, since it is sensitive.
+func describeLookup(config *ContactAddressDataSourceModel) string {
+	switch {
+	case !config.Type.IsNull() && !config.Address.IsNull():
+		return fmt.Sprintf("type %q and the given address", config.Type.ValueString())
+	case !config.Type.IsNull():
+		return fmt.Sprintf("type %q", config.Type.ValueString())
+	case !config.Address.IsNull():
+		return "the given address"
+	}
+	return "the lookup"
+}
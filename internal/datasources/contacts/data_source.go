@@ -3,6 +3,7 @@ package contacts
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/contact"
 )
 
 var _ datasource.DataSource = &ContactsDataSource{}
@@ -20,21 +22,18 @@ type ContactsDataSource struct {
 }
 
 type ContactsDataSourceModel struct {
-	Contacts []ContactModel `tfsdk:"contacts"`
+	Name           types.String   `tfsdk:"name"`
+	AddressType    types.String   `tfsdk:"address_type"`
+	AllSubaccounts types.Bool     `tfsdk:"all_subaccounts"`
+	Contacts       []ContactModel `tfsdk:"contacts"`
 }
 
 type ContactModel struct {
-	ID         types.String   `tfsdk:"id"`
-	CustomerID types.String   `tfsdk:"customer_id"`
-	Name       types.String   `tfsdk:"name"`
-	CustRole   types.String   `tfsdk:"custrole"`
-	Addresses  []AddressModel `tfsdk:"addresses"`
-}
-
-type AddressModel struct {
-	ID      types.String `tfsdk:"id"`
-	Type    types.String `tfsdk:"type"`
-	Address types.String `tfsdk:"address"`
+	ID         types.String                     `tfsdk:"id"`
+	CustomerID types.String                     `tfsdk:"customer_id"`
+	Name       types.String                     `tfsdk:"name"`
+	CustRole   types.String                     `tfsdk:"custrole"`
+	Addresses  []contact.AddressDataSourceModel `tfsdk:"addresses"`
 }
 
 func NewContactsDataSource() datasource.DataSource {
@@ -47,23 +46,39 @@ func (d *ContactsDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *ContactsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches all NodePing contacts.",
+		Description: "Fetches all NodePing contacts with optional filtering.",
 		MarkdownDescription: `
-Fetches all NodePing contacts.
+Fetches all NodePing contacts with optional filtering.
 
 ## Example Usage
 
 ` + "```hcl" + `
 data "nodeping_contacts" "all" {}
 
+data "nodeping_contacts" "webhooks" {
+  address_type = "webhook"
+}
+
 output "contact_ids" {
   value = [for c in data.nodeping_contacts.all.contacts : c.id]
 }
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Filter contacts by exact name.",
+				Optional:    true,
+			},
+			"address_type": schema.StringAttribute{
+				Description: "Filter contacts having at least one address of this type, such as email or webhook.",
+				Optional:    true,
+			},
+			"all_subaccounts": schema.BoolAttribute{
+				Description: "List the contacts of the provider's account and all of its SubAccounts. SubAccounts that cannot be read are reported as warnings. Defaults to false.",
+				Optional:    true,
+			},
 			"contacts": schema.ListNestedAttribute{
-				Description: "List of contacts.",
+				Description: "List of contacts, sorted by ID.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -84,24 +99,10 @@ output "contact_ids" {
 							Computed:    true,
 						},
 						"addresses": schema.ListNestedAttribute{
-							Description: "Contact addresses.",
+							Description: "Contact addresses, sorted by ID.",
 							Computed:    true,
 							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"id": schema.StringAttribute{
-										Description: "The unique identifier of the address.",
-										Computed:    true,
-									},
-									"type": schema.StringAttribute{
-										Description: "The type of address.",
-										Computed:    true,
-									},
-									"address": schema.StringAttribute{
-										Description: "The address value.",
-										Computed:    true,
-										Sensitive:   true,
-									},
-								},
+								Attributes: contact.AddressAttributes(),
 							},
 						},
 					},
@@ -129,9 +130,28 @@ func (d *ContactsDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *ContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContactsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Reading contacts data source")
 
-	contacts, err := d.client.ListContacts(ctx)
+	var contacts map[string]client.Contact
+	var err error
+	if config.AllSubaccounts.ValueBool() {
+		var failures []client.AccountFailure
+		contacts, failures, err = d.client.ListContactsAllAccounts(ctx)
+		for _, failure := range failures {
+			resp.Diagnostics.AddWarning(
+				"Error Reading SubAccount Contacts",
+				"Could not list the contacts of "+failure.Account()+", so they are missing from the result: "+failure.Err.Error(),
+			)
+		}
+	} else {
+		contacts, err = d.client.ListContacts(ctx)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Contacts",
@@ -140,28 +160,39 @@ func (d *ContactsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	var state ContactsDataSourceModel
-	state.Contacts = make([]ContactModel, 0, len(contacts))
+	ids := make([]string, 0, len(contacts))
+	for id := range contacts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
 
-	for _, contact := range contacts {
-		contactModel := ContactModel{
-			ID:         types.StringValue(contact.ID),
-			CustomerID: types.StringValue(contact.CustomerID),
-			Name:       types.StringValue(contact.Name),
-			CustRole:   types.StringValue(contact.CustRole),
+	config.Contacts = make([]ContactModel, 0, len(contacts))
+	for _, id := range ids {
+		c := contacts[id]
+		if !config.Name.IsNull() && c.Name != config.Name.ValueString() {
+			continue
 		}
-
-		contactModel.Addresses = make([]AddressModel, 0, len(contact.Addresses))
-		for id, addr := range contact.Addresses {
-			contactModel.Addresses = append(contactModel.Addresses, AddressModel{
-				ID:      types.StringValue(id),
-				Type:    types.StringValue(addr.Type),
-				Address: types.StringValue(addr.Address),
-			})
+		if !config.AddressType.IsNull() && !hasAddressType(c, config.AddressType.ValueString()) {
+			continue
 		}
 
-		state.Contacts = append(state.Contacts, contactModel)
+		config.Contacts = append(config.Contacts, ContactModel{
+			ID:         types.StringValue(c.ID),
+			CustomerID: types.StringValue(c.CustomerID),
+			Name:       types.StringValue(c.Name),
+			CustRole:   types.StringValue(c.CustRole),
+			Addresses:  contact.MapAddresses(ctx, d.client, &c),
+		})
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func hasAddressType(c client.Contact, addressType string) bool {
+	for _, addr := range c.Addresses {
+		if addr.Type == addressType {
+			return true
+		}
+	}
+	return false
 }
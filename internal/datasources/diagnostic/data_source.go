@@ -0,0 +1,234 @@
+package diagnostic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+var _ datasource.DataSource = &DiagnosticDataSource{}
+var _ datasource.DataSourceWithConfigure = &DiagnosticDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DiagnosticDataSource{}
+
+type DiagnosticDataSource struct {
+	client *client.Client
+}
+
+type DiagnosticDataSourceModel struct {
+	CheckID   types.String   `tfsdk:"check_id"`
+	Target    types.String   `tfsdk:"target"`
+	Tool      types.String   `tfsdk:"tool"`
+	Location  types.String   `tfsdk:"location"`
+	PingCount types.Int64    `tfsdk:"ping_count"`
+	DNSServer types.String   `tfsdk:"dns_server"`
+	DNSType   types.String   `tfsdk:"dns_type"`
+	Probe     types.String   `tfsdk:"probe"`
+	Output    types.String   `tfsdk:"output"`
+	Lines     []types.String `tfsdk:"lines"`
+	Error     types.String   `tfsdk:"error"`
+	RawJSON   types.String   `tfsdk:"raw_json"`
+}
+
+func NewDiagnosticDataSource() datasource.DataSource {
+	return &DiagnosticDataSource{}
+}
+
+func (d *DiagnosticDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diagnostic"
+}
+
+func (d *DiagnosticDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a diagnostic tool from a NodePing probe.",
+		MarkdownDescription: `
+Runs a diagnostic tool (ping, mtr, dig or traceroute) from a NodePing probe against a check's target or any other target. The diagnostic runs again on every refresh.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_diagnostic" "mtr" {
+  check_id = "201205050153W2Q4C-0J2HSIRF"
+  tool     = "mtr"
+  location = "nam"
+}
+
+output "mtr" {
+  value = data.nodeping_diagnostic.mtr.output
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.StringAttribute{
+				Description: "Run the diagnostic against this check's target. Conflicts with target.",
+				Optional:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Hostname, IP address or URL to run the diagnostic against. Conflicts with check_id; with check_id, it is set to the check's target.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tool": schema.StringAttribute{
+				Description: "The diagnostic tool: ping, mtr, dig or traceroute.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.DiagnosticTools...),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "The region or probe to run the diagnostic from, as used in runlocations.",
+				Required:    true,
+			},
+			"ping_count": schema.Int64Attribute{
+				Description: "Number of pings to send. Only used by ping.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"dns_server": schema.StringAttribute{
+				Description: "DNS server to query. Only used by dig.",
+				Optional:    true,
+			},
+			"dns_type": schema.StringAttribute{
+				Description: "DNS record type to query. Only used by dig.",
+				Optional:    true,
+			},
+			"probe": schema.StringAttribute{
+				Description: "The probe that ran the diagnostic, as reported by the API.",
+				Computed:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "The tool's output.",
+				Computed:    true,
+			},
+			"lines": schema.ListAttribute{
+				Description: "The tool's output, one element per line.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"error": schema.StringAttribute{
+				Description: "The error reported by the probe, if the diagnostic could not run.",
+				Computed:    true,
+			},
+			"raw_json": schema.StringAttribute{
+				Description: "The full API response as JSON. Its fields depend on the tool; use jsondecode to read them.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DiagnosticDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("check_id"),
+			path.MatchRoot("target"),
+		),
+	}
+}
+
+func (d *DiagnosticDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DiagnosticDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DiagnosticDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diagReq := client.DiagnosticRequest{
+		CheckID:   config.CheckID.ValueString(),
+		Target:    config.Target.ValueString(),
+		Tool:      config.Tool.ValueString(),
+		Location:  config.Location.ValueString(),
+		Count:     int(config.PingCount.ValueInt64()),
+		DNSServer: config.DNSServer.ValueString(),
+		DNSType:   config.DNSType.ValueString(),
+	}
+
+	// Resolve the check first, so the target is known even if the probe
+	// does not echo it
+	target := diagReq.Target
+	if diagReq.CheckID != "" {
+		check, err := d.client.GetCheck(ctx, diagReq.CheckID)
+		if err != nil {
+			var notFound *client.NotFoundError
+			if errors.As(err, &notFound) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("check_id"),
+					"No Matching Check",
+					"No check has ID "+diagReq.CheckID+".",
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Check",
+				"Could not read check ID "+diagReq.CheckID+": "+err.Error(),
+			)
+			return
+		}
+		target = check.Parameters.Target
+	}
+
+	tflog.Debug(ctx, "Running diagnostic", map[string]interface{}{
+		"tool":     diagReq.Tool,
+		"location": diagReq.Location,
+		"target":   target,
+	})
+
+	result, err := d.client.RunDiagnostic(ctx, diagReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Diagnostic",
+			"Could not run "+diagReq.Tool+" from "+diagReq.Location+": "+err.Error(),
+		)
+		return
+	}
+
+	if result.Error != "" {
+		resp.Diagnostics.AddWarning(
+			"Diagnostic Reported an Error",
+			fmt.Sprintf("%s from %s against %s reported: %s", diagReq.Tool, result.Location, target, result.Error),
+		)
+	}
+
+	config.Target = types.StringValue(target)
+	config.Probe = types.StringValue(result.Location)
+	config.Output = types.StringValue(result.Output)
+	config.Error = types.StringValue(result.Error)
+	config.RawJSON = types.StringValue(string(result.Raw))
+
+	config.Lines = make([]types.String, 0)
+	for _, line := range result.Lines() {
+		config.Lines = append(config.Lines, types.StringValue(line))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,251 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+var _ datasource.DataSource = &NotificationsDataSource{}
+var _ datasource.DataSourceWithConfigure = &NotificationsDataSource{}
+
+type NotificationsDataSource struct {
+	client *client.Client
+}
+
+type NotificationsDataSourceModel struct {
+	CheckID       types.String        `tfsdk:"check_id"`
+	ContactID     types.String        `tfsdk:"contact_id"`
+	Span          types.Int64         `tfsdk:"span"`
+	Limit         types.Int64         `tfsdk:"limit"`
+	Notifications []NotificationModel `tfsdk:"notifications"`
+}
+
+type NotificationModel struct {
+	CheckID            types.String `tfsdk:"check_id"`
+	Time               types.Int64  `tfsdk:"time"`
+	Timestamp          types.String `tfsdk:"timestamp"`
+	Type               types.String `tfsdk:"type"`
+	ContactID          types.String `tfsdk:"contact_id"`
+	Method             types.String `tfsdk:"method"`
+	Address            types.String `tfsdk:"address"`
+	AddressFingerprint types.String `tfsdk:"address_fingerprint"`
+	Message            types.String `tfsdk:"message"`
+	Status             types.String `tfsdk:"status"`
+}
+
+func NewNotificationsDataSource() datasource.DataSource {
+	return &NotificationsDataSource{}
+}
+
+func (d *NotificationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+func (d *NotificationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the history of notifications NodePing sent.",
+		MarkdownDescription: `
+Fetches the history of notifications NodePing sent: when, for which check, to which contact address, and whether it was delivered.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_notifications" "incident" {
+  check_id = "201205050153W2Q4C-0J2HSIRF"
+  span     = 48
+}
+
+output "paged" {
+  value = [for n in data.nodeping_notifications.incident.notifications : "${n.timestamp} ${n.type} via ${n.method}: ${n.status}"]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.StringAttribute{
+				Description: "Only fetch notifications for this check.",
+				Optional:    true,
+			},
+			"contact_id": schema.StringAttribute{
+				Description: "Only return notifications sent to this contact or contact address ID.",
+				Optional:    true,
+			},
+			"span": schema.Int64Attribute{
+				Description: "How many hours back to look. Defaults to the API's default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of notifications the API returns. Applied before contact_id filtering.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description: "Sent notifications, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"check_id": schema.StringAttribute{
+							Description: "The check the notification was about.",
+							Computed:    true,
+						},
+						"time": schema.Int64Attribute{
+							Description: "When the notification was sent, in milliseconds since the epoch.",
+							Computed:    true,
+						},
+						"timestamp": schema.StringAttribute{
+							Description: "When the notification was sent, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The event notified about, such as down, up or first.",
+							Computed:    true,
+						},
+						"contact_id": schema.StringAttribute{
+							Description: "The contact address ID notified.",
+							Computed:    true,
+						},
+						"method": schema.StringAttribute{
+							Description: "How the notification was sent, such as email, sms or webhook.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "The address the notification was sent to. Redacted when the provider's mask_pii is set.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"address_fingerprint": schema.StringAttribute{
+							Description: "HMAC-SHA256 fingerprint of the address, for equality checks without exposing it. Keyed with the provider's pii_fingerprint_key, so it changes when the key does. Null when the key is not set.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The notification message.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The delivery status reported by the API.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NotificationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading notifications data source", map[string]interface{}{
+		"check_id":   config.CheckID.ValueString(),
+		"contact_id": config.ContactID.ValueString(),
+	})
+
+	var addressIDs map[string]bool
+	if !config.ContactID.IsNull() {
+		addressIDs = d.contactAddressIDs(ctx, config.ContactID.ValueString(), resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	sent, err := d.client.GetNotificationHistory(ctx, client.NotificationHistoryQuery{
+		CheckID: config.CheckID.ValueString(),
+		Span:    int(config.Span.ValueInt64()),
+		Limit:   int(config.Limit.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Notifications",
+			"Could not read notification history: "+err.Error(),
+		)
+		return
+	}
+
+	config.Notifications = make([]NotificationModel, 0, len(sent))
+	for _, n := range sent {
+		if addressIDs != nil && !addressIDs[n.ContactID] {
+			continue
+		}
+
+		fingerprint := types.StringNull()
+		if fp, ok := d.client.AddressFingerprint(n.Destination); ok {
+			fingerprint = types.StringValue(fp)
+		}
+
+		config.Notifications = append(config.Notifications, NotificationModel{
+			CheckID:            types.StringValue(n.CheckID),
+			Time:               types.Int64Value(n.Time),
+			Timestamp:          types.StringValue(time.UnixMilli(n.Time).UTC().Format(time.RFC3339)),
+			Type:               types.StringValue(n.Type),
+			ContactID:          types.StringValue(n.ContactID),
+			Method:             types.StringValue(n.Method),
+			Address:            types.StringValue(d.client.PresentAddress(n.Destination)),
+			AddressFingerprint: fingerprint,
+			Message:            types.StringValue(n.Message),
+			Status:             types.StringValue(n.Status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// contactAddressIDs returns the address IDs a contact_id filter matches:
+// the ID itself, and every address of the contact when it names one.
+func (d *NotificationsDataSource) contactAddressIDs(ctx context.Context, id string, resp *datasource.ReadResponse) map[string]bool {
+	ids := map[string]bool{id: true}
+
+	contact, err := d.client.GetContact(ctx, id)
+	if err != nil {
+		var notFound *client.NotFoundError
+		if errors.As(err, &notFound) {
+			// Not a contact, so an address ID
+			return ids
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Contact",
+			"Could not read contact ID "+id+": "+err.Error(),
+		)
+		return nil
+	}
+
+	for addressID := range contact.Addresses {
+		ids[addressID] = true
+	}
+	return ids
+}
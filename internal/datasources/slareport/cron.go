@@ -0,0 +1,144 @@
+package slareport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed five-field cron expression: minute, hour, day of
+// month, month and day of week. Each field holds the values it matches.
+type cronSchedule struct {
+	minutes  [60]bool
+	hours    [24]bool
+	days     [32]bool
+	months   [13]bool
+	weekdays [7]bool
+
+	// Cron matches a day on either field when both day fields are
+	// restricted, and on the restricted one otherwise.
+	daysRestricted     bool
+	weekdaysRestricted bool
+}
+
+// parseCron parses a cron expression such as "30 2 * * 1-5". Fields accept
+// *, single values, ranges, steps and comma-separated lists. Day of week 7
+// is Sunday, as 0 is.
+func parseCron(expr string) (cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("invalid cron expression %q, expected 5 fields", expr)
+	}
+
+	var s cronSchedule
+	var err error
+	if err = parseCronField(fields[0], 0, 59, s.minutes[:]); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid cron minute %q: %w", fields[0], err)
+	}
+	if err = parseCronField(fields[1], 0, 23, s.hours[:]); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid cron hour %q: %w", fields[1], err)
+	}
+	if err = parseCronField(fields[2], 1, 31, s.days[:]); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid cron day of month %q: %w", fields[2], err)
+	}
+	if err = parseCronField(fields[3], 1, 12, s.months[:]); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid cron month %q: %w", fields[3], err)
+	}
+	var weekdays [8]bool
+	if err = parseCronField(fields[4], 0, 7, weekdays[:]); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid cron day of week %q: %w", fields[4], err)
+	}
+	copy(s.weekdays[:], weekdays[:7])
+	s.weekdays[0] = s.weekdays[0] || weekdays[7]
+
+	s.daysRestricted = !strings.HasPrefix(fields[2], "*")
+	s.weekdaysRestricted = !strings.HasPrefix(fields[4], "*")
+	return s, nil
+}
+
+// parseCronField sets the values of one field, between min and max, that
+// the field matches.
+func parseCronField(field string, min, max int, match []bool) error {
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, s, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q", s)
+			}
+			part, step = base, n
+		}
+
+		lo, hi := min, max
+		if part != "*" {
+			first, last, isRange := strings.Cut(part, "-")
+			var err error
+			if lo, err = strconv.Atoi(first); err != nil {
+				return fmt.Errorf("invalid value %q", first)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(last); err != nil {
+					return fmt.Errorf("invalid value %q", last)
+				}
+			} else if step > 1 {
+				// "5/15" runs from 5 to the end of the range
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			match[v] = true
+		}
+	}
+	return nil
+}
+
+// matchesDay reports whether the schedule runs on the day of t.
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	if !s.months[t.Month()] {
+		return false
+	}
+	day, weekday := s.days[t.Day()], s.weekdays[t.Weekday()]
+	switch {
+	case s.daysRestricted && s.weekdaysRestricted:
+		return day || weekday
+	case s.daysRestricted:
+		return day
+	case s.weekdaysRestricted:
+		return weekday
+	}
+	return true
+}
+
+// Between returns the times the schedule fires from from up to, but not
+// including, to. Times are in UTC.
+func (s cronSchedule) Between(from, to time.Time) []time.Time {
+	from, to = from.UTC(), to.UTC()
+
+	var times []time.Time
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !s.matchesDay(day) {
+			continue
+		}
+		for h, hour := range s.hours {
+			if !hour {
+				continue
+			}
+			for m, minute := range s.minutes {
+				if !minute {
+					continue
+				}
+				t := day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+				if !t.Before(from) && t.Before(to) {
+					times = append(times, t)
+				}
+			}
+		}
+	}
+	return times
+}
@@ -0,0 +1,403 @@
+package slareport
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+var _ datasource.DataSource = &SLAReportDataSource{}
+var _ datasource.DataSourceWithConfigure = &SLAReportDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SLAReportDataSource{}
+
+type SLAReportDataSource struct {
+	client *client.Client
+}
+
+type SLAReportDataSourceModel struct {
+	CheckIDs         []types.String  `tfsdk:"check_ids"`
+	Tag              types.String    `tfsdk:"tag"`
+	Month            types.String    `tfsdk:"month"`
+	Quarter          types.String    `tfsdk:"quarter"`
+	StartDate        types.String    `tfsdk:"start_date"`
+	EndDate          types.String    `tfsdk:"end_date"`
+	Target           types.Float64   `tfsdk:"target"`
+	PeriodStart      types.String    `tfsdk:"period_start"`
+	PeriodEnd        types.String    `tfsdk:"period_end"`
+	Complete         types.Bool      `tfsdk:"complete"`
+	Uptime           types.Float64   `tfsdk:"uptime"`
+	DowntimeMinutes  types.Float64   `tfsdk:"downtime_minutes"`
+	MonitoredMinutes types.Float64   `tfsdk:"monitored_minutes"`
+	Breached         types.Bool      `tfsdk:"breached"`
+	BreachedCheckIDs []types.String  `tfsdk:"breached_check_ids"`
+	Checks           []CheckSLAModel `tfsdk:"checks"`
+}
+
+type CheckSLAModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Label            types.String  `tfsdk:"label"`
+	Uptime           types.Float64 `tfsdk:"uptime"`
+	DowntimeMinutes  types.Float64 `tfsdk:"downtime_minutes"`
+	MonitoredMinutes types.Float64 `tfsdk:"monitored_minutes"`
+	Breached         types.Bool    `tfsdk:"breached"`
+}
+
+func NewSLAReportDataSource() datasource.DataSource {
+	return &SLAReportDataSource{}
+}
+
+func (d *SLAReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_report"
+}
+
+func (d *SLAReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes uptime and SLA breaches for a set of checks over a month, quarter or custom period.",
+		MarkdownDescription: `
+Computes uptime, downtime and SLA breaches for a set of checks over a month, quarter or custom period, from NodePing's uptime results. Periods are in UTC. Only time a check was enabled counts as monitored time, and the check's scheduled maintenance windows are excluded from both monitored time and downtime.
+
+## Example Usage
+
+` + "```hcl" + `
+data "nodeping_sla_report" "production" {
+  tag     = "production"
+  quarter = "2026-Q3"
+  target  = 99.9
+}
+
+output "sla_breaches" {
+  value = data.nodeping_sla_report.production.breached_check_ids
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"check_ids": schema.ListAttribute{
+				Description: "IDs of the checks to report on. Conflicts with tag.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Description: "Report on the checks carrying this tag. Conflicts with check_ids.",
+				Optional:    true,
+			},
+			"month": schema.StringAttribute{
+				Description: "Report on a calendar month, as YYYY-MM.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`), "must be a month as YYYY-MM"),
+				},
+			},
+			"quarter": schema.StringAttribute{
+				Description: "Report on a calendar quarter, as YYYY-Q1 to YYYY-Q4.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-Q[1-4]$`), "must be a quarter as YYYY-Q1 to YYYY-Q4"),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Description: "First day of a custom period, as YYYY-MM-DD. Requires end_date.",
+				Optional:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Last day of a custom period, as YYYY-MM-DD. The whole day is included. Requires start_date.",
+				Optional:    true,
+			},
+			"target": schema.Float64Attribute{
+				Description: "The SLA target uptime percentage, such as 99.9.",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"period_start": schema.StringAttribute{
+				Description: "Start of the period, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"period_end": schema.StringAttribute{
+				Description: "End of the period (exclusive), in RFC 3339 format.",
+				Computed:    true,
+			},
+			"complete": schema.BoolAttribute{
+				Description: "Whether the period has ended. The figures of a complete period no longer change.",
+				Computed:    true,
+			},
+			"uptime": schema.Float64Attribute{
+				Description: "Uptime percentage of all checks together. Null if none of them was monitored during the period.",
+				Computed:    true,
+			},
+			"downtime_minutes": schema.Float64Attribute{
+				Description: "Downtime of all checks together outside maintenance windows, in minutes.",
+				Computed:    true,
+			},
+			"monitored_minutes": schema.Float64Attribute{
+				Description: "Time all checks were enabled outside maintenance windows, in minutes.",
+				Computed:    true,
+			},
+			"breached": schema.BoolAttribute{
+				Description: "Whether the aggregate uptime is below target.",
+				Computed:    true,
+			},
+			"breached_check_ids": schema.ListAttribute{
+				Description: "IDs of the checks whose uptime is below target, sorted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "Figures for each check, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The check ID.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The check label.",
+							Computed:    true,
+						},
+						"uptime": schema.Float64Attribute{
+							Description: "Uptime percentage. Null if the check was not monitored during the period.",
+							Computed:    true,
+						},
+						"downtime_minutes": schema.Float64Attribute{
+							Description: "Downtime outside maintenance windows, in minutes.",
+							Computed:    true,
+						},
+						"monitored_minutes": schema.Float64Attribute{
+							Description: "Time the check was enabled outside maintenance windows, in minutes.",
+							Computed:    true,
+						},
+						"breached": schema.BoolAttribute{
+							Description: "Whether the uptime is below target.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SLAReportDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("check_ids"),
+			path.MatchRoot("tag"),
+		),
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("month"),
+			path.MatchRoot("quarter"),
+			path.MatchRoot("start_date"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("start_date"),
+			path.MatchRoot("end_date"),
+		),
+	}
+}
+
+func (d *SLAReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *SLAReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SLAReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	period, attr, err := parsePeriod(config)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attr, "Invalid Period", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading SLA report data source", map[string]interface{}{
+		"tag":          config.Tag.ValueString(),
+		"period_start": period.Start.Format(time.RFC3339),
+		"period_end":   period.End.Format(time.RFC3339),
+	})
+
+	checks := d.selectChecks(ctx, config, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := d.client.ListMaintenance(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SLA Report",
+			"Could not read maintenance schedules: "+err.Error(),
+		)
+		return
+	}
+
+	target := config.Target.ValueFloat64()
+	var total slaFigures
+
+	config.Checks = make([]CheckSLAModel, 0, len(checks))
+	config.BreachedCheckIDs = []types.String{}
+	for _, check := range checks {
+		figures, err := d.checkFigures(ctx, check.ID, period, schedules)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SLA Report",
+				"Could not read uptime for check ID "+check.ID+": "+err.Error(),
+			)
+			return
+		}
+		total.Add(client.Uptime{Enabled: figures.Monitored, Down: figures.Down})
+
+		breached := figures.Breached(target)
+		if breached {
+			config.BreachedCheckIDs = append(config.BreachedCheckIDs, types.StringValue(check.ID))
+		}
+		config.Checks = append(config.Checks, CheckSLAModel{
+			ID:               types.StringValue(check.ID),
+			Label:            types.StringValue(check.Label),
+			Uptime:           uptimeValue(figures),
+			DowntimeMinutes:  types.Float64Value(figures.DowntimeMinutes()),
+			MonitoredMinutes: types.Float64Value(figures.MonitoredMinutes()),
+			Breached:         types.BoolValue(breached),
+		})
+	}
+
+	config.PeriodStart = types.StringValue(period.Start.Format(time.RFC3339))
+	config.PeriodEnd = types.StringValue(period.End.Format(time.RFC3339))
+	config.Complete = types.BoolValue(period.Closed(time.Now()))
+	config.Uptime = uptimeValue(total)
+	config.DowntimeMinutes = types.Float64Value(total.DowntimeMinutes())
+	config.MonitoredMinutes = types.Float64Value(total.MonitoredMinutes())
+	config.Breached = types.BoolValue(total.Breached(target))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// checkFigures returns the uptime of a check over the period, less the
+// uptime during its maintenance windows.
+func (d *SLAReportDataSource) checkFigures(ctx context.Context, checkID string, period slaPeriod, schedules map[string]client.Maintenance) (slaFigures, error) {
+	var figures slaFigures
+
+	buckets, err := d.client.GetCheckUptime(ctx, checkID, client.UptimeQuery{
+		Interval: "months",
+		Start:    period.Start,
+		End:      period.End.Add(-time.Millisecond),
+	})
+	if err != nil {
+		return figures, err
+	}
+	figures.Add(client.TotalUptime(buckets))
+
+	windows, err := maintenanceWindows(schedules, checkID, period)
+	if err != nil {
+		return figures, err
+	}
+	for _, window := range windows {
+		buckets, err := d.client.GetCheckUptime(ctx, checkID, client.UptimeQuery{
+			Start: window.Start,
+			End:   window.End.Add(-time.Millisecond),
+		})
+		if err != nil {
+			return figures, fmt.Errorf("maintenance window from %s: %w", window.Start.Format(time.RFC3339), err)
+		}
+		figures.Subtract(client.TotalUptime(buckets))
+	}
+	return figures, nil
+}
+
+// selectChecks returns the checks named by check_ids or carrying tag,
+// sorted by ID.
+func (d *SLAReportDataSource) selectChecks(ctx context.Context, config SLAReportDataSourceModel, resp *datasource.ReadResponse) []client.Check {
+	all, err := d.client.ListChecks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Checks",
+			"Could not read checks: "+err.Error(),
+		)
+		return nil
+	}
+
+	var tags []string
+	if !config.Tag.IsNull() {
+		tags = []string{config.Tag.ValueString()}
+	}
+	ids := make([]string, 0, len(config.CheckIDs))
+	for _, id := range config.CheckIDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	checks, missing, err := client.SelectChecks(all, ids, tags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tag"), "Invalid Tag", err.Error())
+		return nil
+	}
+	for _, id := range missing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_ids"),
+			"No Matching Check",
+			"No check found with ID "+id+".",
+		)
+	}
+	if tags != nil && len(checks) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tag"),
+			"No Matching Checks",
+			fmt.Sprintf("No checks carry the tag %q.", config.Tag.ValueString()),
+		)
+	}
+	return checks
+}
+
+// parsePeriod returns the configured period, or the attribute at fault.
+func parsePeriod(config SLAReportDataSourceModel) (slaPeriod, path.Path, error) {
+	switch {
+	case !config.Month.IsNull():
+		period, err := monthPeriod(config.Month.ValueString())
+		return period, path.Root("month"), err
+	case !config.Quarter.IsNull():
+		period, err := quarterPeriod(config.Quarter.ValueString())
+		return period, path.Root("quarter"), err
+	default:
+		period, err := customPeriod(config.StartDate.ValueString(), config.EndDate.ValueString())
+		return period, path.Root("start_date"), err
+	}
+}
+
+func uptimeValue(f slaFigures) types.Float64 {
+	uptime, ok := f.UptimePercent()
+	if !ok {
+		return types.Float64Null()
+	}
+	return types.Float64Value(uptime)
+}
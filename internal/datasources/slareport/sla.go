@@ -0,0 +1,168 @@
+package slareport
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+// slaPeriod is a reporting period from Start up to, but not including, End.
+// Periods are in UTC.
+type slaPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+var quarterPattern = regexp.MustCompile(`^(\d{4})-Q([1-4])$`)
+
+// monthPeriod returns the period of a calendar month given as YYYY-MM.
+func monthPeriod(month string) (slaPeriod, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return slaPeriod{}, fmt.Errorf("invalid month %q, expected YYYY-MM", month)
+	}
+	return slaPeriod{Start: start, End: start.AddDate(0, 1, 0)}, nil
+}
+
+// quarterPeriod returns the period of a calendar quarter given as YYYY-QN.
+func quarterPeriod(quarter string) (slaPeriod, error) {
+	m := quarterPattern.FindStringSubmatch(quarter)
+	if m == nil {
+		return slaPeriod{}, fmt.Errorf("invalid quarter %q, expected YYYY-Q1 to YYYY-Q4", quarter)
+	}
+	year, _ := strconv.Atoi(m[1])
+	q, _ := strconv.Atoi(m[2])
+	start := time.Date(year, time.Month(3*(q-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return slaPeriod{Start: start, End: start.AddDate(0, 3, 0)}, nil
+}
+
+// customPeriod returns the period from the start of the first date to the
+// end of the last date, both given as YYYY-MM-DD.
+func customPeriod(first, last string) (slaPeriod, error) {
+	start, err := time.Parse(time.DateOnly, first)
+	if err != nil {
+		return slaPeriod{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", first)
+	}
+	end, err := time.Parse(time.DateOnly, last)
+	if err != nil {
+		return slaPeriod{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", last)
+	}
+	if end.Before(start) {
+		return slaPeriod{}, fmt.Errorf("end date %s is before start date %s", last, first)
+	}
+	return slaPeriod{Start: start, End: end.AddDate(0, 0, 1)}, nil
+}
+
+// Closed reports whether the period has ended by now, so that its figures
+// no longer change.
+func (p slaPeriod) Closed(now time.Time) bool {
+	return !now.Before(p.End)
+}
+
+// slaFigures is the monitored and down time of one or more checks, in
+// milliseconds.
+type slaFigures struct {
+	Monitored int64
+	Down      int64
+}
+
+// Add accumulates another check's uptime.
+func (f *slaFigures) Add(u client.Uptime) {
+	f.Monitored += u.Enabled
+	f.Down += u.Down
+}
+
+// Subtract removes the uptime of a maintenance window, which is already
+// part of the figures.
+func (f *slaFigures) Subtract(u client.Uptime) {
+	f.Monitored = max(0, f.Monitored-u.Enabled)
+	f.Down = max(0, min(f.Down-u.Down, f.Monitored))
+}
+
+// UptimePercent returns the percentage of monitored time that was up,
+// rounded to four decimal places. It returns false if nothing was monitored.
+func (f slaFigures) UptimePercent() (float64, bool) {
+	if f.Monitored <= 0 {
+		return 0, false
+	}
+	up := float64(f.Monitored-f.Down) / float64(f.Monitored) * 100
+	return math.Max(0, math.Round(up*1e4)/1e4), true
+}
+
+// DowntimeMinutes returns the down time in minutes, rounded to two decimal
+// places.
+func (f slaFigures) DowntimeMinutes() float64 {
+	return millisToMinutes(f.Down)
+}
+
+// MonitoredMinutes returns the monitored time in minutes, rounded to two
+// decimal places.
+func (f slaFigures) MonitoredMinutes() float64 {
+	return millisToMinutes(f.Monitored)
+}
+
+// Breached reports whether the uptime is below target. Figures with no
+// monitored time never breach.
+func (f slaFigures) Breached(target float64) bool {
+	uptime, ok := f.UptimePercent()
+	return ok && uptime < target
+}
+
+func millisToMinutes(ms int64) float64 {
+	return math.Round(float64(ms)/600) / 100
+}
+
+// maintenanceWindows returns the parts of the period covered by the
+// enabled scheduled maintenance of a check, merged and sorted. Windows
+// that start before the period but run into it are included. Ad-hoc
+// maintenance has no schedule to replay and is left out.
+func maintenanceWindows(schedules map[string]client.Maintenance, checkID string, period slaPeriod) ([]slaPeriod, error) {
+	var windows []slaPeriod
+	for _, m := range schedules {
+		if !m.Enabled || m.Cron == "" || m.Duration <= 0 || !slices.Contains(m.CheckList, checkID) {
+			continue
+		}
+
+		schedule, err := parseCron(m.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance %s (%s): %w", m.ID, m.Name, err)
+		}
+
+		duration := time.Duration(m.Duration) * time.Minute
+		for _, start := range schedule.Between(period.Start.Add(-duration), period.End) {
+			window := slaPeriod{Start: start, End: start.Add(duration)}
+			if window.Start.Before(period.Start) {
+				window.Start = period.Start
+			}
+			if window.End.After(period.End) {
+				window.End = period.End
+			}
+			if window.Start.Before(window.End) {
+				windows = append(windows, window)
+			}
+		}
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+
+	// Overlapping schedules must not subtract the same time twice
+	merged := make([]slaPeriod, 0, len(windows))
+	for _, w := range windows {
+		if n := len(merged); n > 0 && !w.Start.After(merged[n-1].End) {
+			if w.End.After(merged[n-1].End) {
+				merged[n-1].End = w.End
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged, nil
+}
@@ -0,0 +1,200 @@
+package slareport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+func TestSLAPeriods(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() (slaPeriod, error)
+		start string
+		end   string
+	}{
+		{"month", func() (slaPeriod, error) { return monthPeriod("2025-12") }, "2025-12-01", "2026-01-01"},
+		{"quarter", func() (slaPeriod, error) { return quarterPeriod("2025-Q3") }, "2025-07-01", "2025-10-01"},
+		{"custom", func() (slaPeriod, error) { return customPeriod("2025-09-10", "2025-09-12") }, "2025-09-10", "2025-09-13"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := tt.parse()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := period.Start.Format(time.DateOnly); got != tt.start {
+				t.Errorf("expected start %s, got %s", tt.start, got)
+			}
+			if got := period.End.Format(time.DateOnly); got != tt.end {
+				t.Errorf("expected end %s, got %s", tt.end, got)
+			}
+			if period.Start.Location() != time.UTC {
+				t.Errorf("expected UTC, got %s", period.Start.Location())
+			}
+		})
+	}
+}
+
+func TestSLAPeriodErrors(t *testing.T) {
+	if _, err := monthPeriod("2025-13"); err == nil {
+		t.Error("expected error for invalid month")
+	}
+	if _, err := quarterPeriod("2025-Q5"); err == nil {
+		t.Error("expected error for invalid quarter")
+	}
+	if _, err := customPeriod("2025-09-12", "2025-09-10"); err == nil {
+		t.Error("expected error for end before start")
+	}
+}
+
+func TestSLAPeriodClosed(t *testing.T) {
+	period, _ := monthPeriod("2025-09")
+	if period.Closed(time.Date(2025, 9, 30, 23, 0, 0, 0, time.UTC)) {
+		t.Error("expected period to be open before its end")
+	}
+	if !period.Closed(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected period to be closed at its end")
+	}
+}
+
+func TestSLAFigures(t *testing.T) {
+	var f slaFigures
+	if _, ok := f.UptimePercent(); ok {
+		t.Error("expected no uptime without monitored time")
+	}
+	if f.Breached(99.9) {
+		t.Error("expected no breach without monitored time")
+	}
+
+	// 30 days with 90 minutes down
+	f.Add(client.Uptime{Enabled: 30 * 24 * 60 * 60000, Down: 90 * 60000})
+	uptime, ok := f.UptimePercent()
+	if !ok || uptime != 99.7917 {
+		t.Errorf("expected uptime 99.7917, got %v", uptime)
+	}
+	if got := f.DowntimeMinutes(); got != 90 {
+		t.Errorf("expected 90 downtime minutes, got %v", got)
+	}
+	if got := f.MonitoredMinutes(); got != 43200 {
+		t.Errorf("expected 43200 monitored minutes, got %v", got)
+	}
+	if !f.Breached(99.9) {
+		t.Error("expected breach of 99.9")
+	}
+	if f.Breached(99.5) {
+		t.Error("expected no breach of 99.5")
+	}
+}
+
+func TestCronBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want []string
+	}{
+		{"weekly", "0 3 * * 0", []string{"2025-09-07T03:00:00Z", "2025-09-14T03:00:00Z"}},
+		{"sunday as 7", "0 3 * * 7", []string{"2025-09-07T03:00:00Z", "2025-09-14T03:00:00Z"}},
+		{"steps and lists", "*/30 1,2 10 * *", []string{"2025-09-10T01:00:00Z", "2025-09-10T01:30:00Z", "2025-09-10T02:00:00Z", "2025-09-10T02:30:00Z"}},
+		{"day of month or week", "0 0 1 * 1-2", []string{"2025-09-01T00:00:00Z", "2025-09-02T00:00:00Z", "2025-09-08T00:00:00Z", "2025-09-09T00:00:00Z"}},
+	}
+
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := parseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, at := range schedule.Between(from, to) {
+				got = append(got, at.Format(time.RFC3339))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("expected %v, got %v", tt.want, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCronErrors(t *testing.T) {
+	for _, expr := range []string{"0 3 * *", "60 * * * *", "0 3 0 * *", "0 3 * * 1-8", "*/0 * * * *", "a * * * *"} {
+		if _, err := parseCron(expr); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	const checkID = "201205050153W2Q4C-0J2HSIRF"
+	period, _ := monthPeriod("2025-09")
+
+	schedules := map[string]client.Maintenance{
+		// Sundays 23:00 for two hours, the first starting in August
+		"weekly": {ID: "weekly", Enabled: true, Cron: "0 23 * * 0", Duration: 120, CheckList: []string{checkID}},
+		// Overlaps the weekly window ending on 8 September
+		"overlap":  {ID: "overlap", Enabled: true, Cron: "0 0 8 9 *", Duration: 180, CheckList: []string{checkID}},
+		"disabled": {ID: "disabled", Enabled: false, Cron: "0 12 * * *", Duration: 60, CheckList: []string{checkID}},
+		"adhoc":    {ID: "adhoc", Enabled: true, Duration: 60, CheckList: []string{checkID}},
+		"other":    {ID: "other", Enabled: true, Cron: "0 12 * * *", Duration: 60, CheckList: []string{"201205050153W2Q4C-OTHER"}},
+	}
+
+	windows, err := maintenanceWindows(schedules, checkID, period)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, w := range windows {
+		got = append(got, w.Start.Format(time.RFC3339)+"/"+w.End.Format(time.RFC3339))
+	}
+	want := []string{
+		"2025-09-01T00:00:00Z/2025-09-01T01:00:00Z",
+		"2025-09-07T23:00:00Z/2025-09-08T03:00:00Z",
+		"2025-09-14T23:00:00Z/2025-09-15T01:00:00Z",
+		"2025-09-21T23:00:00Z/2025-09-22T01:00:00Z",
+		"2025-09-28T23:00:00Z/2025-09-29T01:00:00Z",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+			break
+		}
+	}
+
+	schedules["broken"] = client.Maintenance{ID: "broken", Enabled: true, Cron: "0 25 * * *", Duration: 60, CheckList: []string{checkID}}
+	if _, err := maintenanceWindows(schedules, checkID, period); err == nil {
+		t.Error("expected error for an invalid cron expression")
+	}
+}
+
+func TestSLAFiguresSubtractMaintenance(t *testing.T) {
+	var f slaFigures
+	f.Add(client.Uptime{Enabled: 30 * 24 * 60 * 60000, Down: 150 * 60000})
+
+	// 120 minutes of maintenance, 60 of them down
+	f.Subtract(client.Uptime{Enabled: 120 * 60000, Down: 60 * 60000})
+	if got := f.MonitoredMinutes(); got != 43080 {
+		t.Errorf("expected 43080 monitored minutes, got %v", got)
+	}
+	if got := f.DowntimeMinutes(); got != 90 {
+		t.Errorf("expected 90 downtime minutes, got %v", got)
+	}
+
+	f.Subtract(client.Uptime{Enabled: 50 * 24 * 60 * 60000, Down: 200 * 60000})
+	if f.Monitored != 0 || f.Down != 0 {
+		t.Errorf("expected figures to stop at zero, got %+v", f)
+	}
+}
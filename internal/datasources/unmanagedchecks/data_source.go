@@ -0,0 +1,231 @@
+package unmanagedchecks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+var _ datasource.DataSource = &UnmanagedChecksDataSource{}
+var _ datasource.DataSourceWithConfigure = &UnmanagedChecksDataSource{}
+
+type UnmanagedChecksDataSource struct {
+	client *client.Client
+}
+
+type UnmanagedChecksDataSourceModel struct {
+	ManagedTag types.String   `tfsdk:"managed_tag"`
+	Type       types.String   `tfsdk:"type"`
+	Checks     []CheckModel   `tfsdk:"checks"`
+	ImportIDs  []types.String `tfsdk:"import_ids"`
+}
+
+type CheckModel struct {
+	ID         types.String `tfsdk:"id"`
+	CustomerID types.String `tfsdk:"customer_id"`
+	Type       types.String `tfsdk:"type"`
+	Target     types.String `tfsdk:"target"`
+	Label      types.String `tfsdk:"label"`
+	Tags       types.List   `tfsdk:"tags"`
+	ImportID   types.String `tfsdk:"import_id"`
+}
+
+func NewUnmanagedChecksDataSource() datasource.DataSource {
+	return &UnmanagedChecksDataSource{}
+}
+
+func (d *UnmanagedChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unmanaged_checks"
+}
+
+func (d *UnmanagedChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists NodePing checks that do not carry the provider's managed tag.",
+		MarkdownDescription: `
+Lists NodePing checks that do not carry the provider's ` + "`managed_tag`" + `, such as checks created by hand in the NodePing UI. Each check comes with the ` + "`customer_id:check_id`" + ` ID that ` + "`import`" + ` blocks for ` + "`nodeping_check`" + ` accept.
+
+## Example Usage
+
+` + "```hcl" + `
+provider "nodeping" {
+  api_token   = var.nodeping_token
+  managed_tag = "terraform"
+}
+
+data "nodeping_unmanaged_checks" "all" {}
+
+output "unmanaged_import_ids" {
+  value = data.nodeping_unmanaged_checks.all.import_ids
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"managed_tag": schema.StringAttribute{
+				Description: "Tag marking managed checks. Defaults to the provider's managed_tag.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Filter checks by type.",
+				Optional:    true,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "Checks without the managed tag, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the check.",
+							Computed:    true,
+						},
+						"customer_id": schema.StringAttribute{
+							Description: "The customer ID (account ID) that owns this check.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of check.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "The target URL, hostname, or IP address.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The label for the check.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags for the check.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the check with, in customer_id:check_id format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"import_ids": schema.ListAttribute{
+				Description: "The import IDs of all listed checks.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *UnmanagedChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *UnmanagedChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UnmanagedChecksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedTag := config.ManagedTag.ValueString()
+	if config.ManagedTag.IsNull() {
+		managedTag = d.client.GetManagedTag()
+	}
+	if managedTag == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("managed_tag"),
+			"Missing Managed Tag",
+			"Set managed_tag on the provider, so the checks it creates are tagged, or on this data source.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading unmanaged checks data source", map[string]interface{}{
+		"managed_tag": managedTag,
+	})
+
+	terms := []client.CheckFilterTerm{}
+	if !config.Type.IsNull() {
+		terms = append(terms, client.CheckFilterTerm{Name: client.CheckFilterType, Values: []string{config.Type.ValueString()}})
+	}
+	filter, err := client.NewCheckFilter(terms)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Check Filter",
+			err.Error(),
+		)
+		return
+	}
+
+	checks, err := d.client.ListChecks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Checks",
+			"Could not list checks: "+err.Error(),
+		)
+		return
+	}
+
+	config.ManagedTag = types.StringValue(managedTag)
+	config.Checks = make([]CheckModel, 0)
+	config.ImportIDs = make([]types.String, 0)
+	for _, check := range filter.Apply(checks) {
+		if hasTag(check.Tags, managedTag) {
+			continue
+		}
+
+		importID := check.ID
+		if check.CustomerID != "" {
+			importID = check.CustomerID + ":" + check.ID
+		}
+
+		checkModel := CheckModel{
+			ID:         types.StringValue(check.ID),
+			CustomerID: types.StringValue(check.CustomerID),
+			Type:       types.StringValue(check.Type),
+			Target:     types.StringValue(check.Parameters.Target),
+			Label:      types.StringValue(check.Label),
+			ImportID:   types.StringValue(importID),
+		}
+
+		if check.Tags != nil {
+			tags, _ := types.ListValueFrom(ctx, types.StringType, check.Tags)
+			checkModel.Tags = tags
+		} else {
+			checkModel.Tags = types.ListNull(types.StringType)
+		}
+
+		config.Checks = append(config.Checks, checkModel)
+		config.ImportIDs = append(config.ImportIDs, checkModel.ImportID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
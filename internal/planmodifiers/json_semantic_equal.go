@@ -1,4 +1,4 @@
-package contact
+package planmodifiers
 
 import (
 	"context"
@@ -13,6 +13,8 @@ import (
 // differences when two JSON strings are semantically equivalent.
 type jsonSemanticEqualModifier struct{}
 
+// JSONSemanticEqual returns a plan modifier that keeps the state value when
+// the planned value is the same JSON document with different formatting.
 func JSONSemanticEqual() planmodifier.String {
 	return jsonSemanticEqualModifier{}
 }
@@ -46,15 +48,15 @@ func (m jsonSemanticEqualModifier) PlanModifyString(ctx context.Context, req pla
 	}
 
 	// Try to parse both as JSON and compare semantically
-	if jsonSemanticEqual(stateVal, planVal) {
+	if JSONSemanticEqualValues(stateVal, planVal) {
 		// They're semantically equal, use the state value to suppress the diff
 		resp.PlanValue = types.StringValue(stateVal)
 	}
 }
 
-// jsonSemanticEqual compares two JSON strings for semantic equality.
+// JSONSemanticEqualValues compares two JSON strings for semantic equality.
 // Returns true if both strings represent the same JSON structure.
-func jsonSemanticEqual(a, b string) bool {
+func JSONSemanticEqualValues(a, b string) bool {
 	var aVal, bVal interface{}
 
 	// Try to unmarshal both strings as JSON
@@ -71,3 +73,20 @@ func jsonSemanticEqual(a, b string) bool {
 	// Compare the unmarshaled values using deep equality
 	return reflect.DeepEqual(aVal, bVal)
 }
+
+// NormalizeJSONString attempts to normalize a JSON string to compact form.
+// If the string is valid JSON, it returns the compact representation.
+// If not valid JSON, it returns the original string unchanged.
+func NormalizeJSONString(s string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		// Not valid JSON, return as-is
+		return s
+	}
+	// Re-marshal to compact JSON
+	compact, err := json.Marshal(v)
+	if err != nil {
+		return s
+	}
+	return string(compact)
+}
@@ -0,0 +1,166 @@
+// Package policy holds the provider's guardrails for checks and evaluates
+// them against a planned check.
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Policy levels. An error fails the plan; a warning is only reported.
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+)
+
+// Policy holds the provider's guardrails for checks, built from the
+// provider's policy block. A nil rule is not enforced.
+type Policy struct {
+	MinIntervals         []MinIntervalRule
+	AllowedRunLocations  *AllowedRunLocationsRule
+	RequireNotifications *Rule
+	RequiredTags         *RequiredTagsRule
+	LabelPattern         *LabelPatternRule
+}
+
+// Rule is a rule with nothing to configure but its level.
+type Rule struct {
+	Level string
+}
+
+// MinIntervalRule sets the shortest interval, in minutes, of checks
+// carrying Tag. An empty Tag applies to every check.
+type MinIntervalRule struct {
+	Tag      string
+	Interval float64
+	Level    string
+}
+
+// AllowedRunLocationsRule lists the regions and probes checks may run from.
+type AllowedRunLocationsRule struct {
+	Values []string
+	Level  string
+}
+
+// RequiredTagsRule lists tags every check must carry.
+type RequiredTagsRule struct {
+	Tags  []string
+	Level string
+}
+
+// LabelPatternRule is a regular expression every check label must match.
+type LabelPatternRule struct {
+	Pattern *regexp.Regexp
+	Level   string
+}
+
+// Check is the planned check a policy is evaluated against. Nil fields are
+// unknown at plan time, and rules depending on them are skipped.
+type Check struct {
+	Label         *string
+	Interval      *float64
+	Enabled       *bool
+	Tags          []string
+	TagsKnown     bool
+	RunLocations  []string
+	Notifications *int
+}
+
+// Violation is a broken rule. Attribute names the check attribute at fault.
+type Violation struct {
+	Rule      string
+	Attribute string
+	Level     string
+	Message   string
+}
+
+// IsError reports whether the violation fails the plan.
+func (v Violation) IsError() bool {
+	return v.Level != LevelWarning
+}
+
+// Evaluate returns every rule the check breaks. A nil policy allows
+// everything.
+func (p *Policy) Evaluate(check Check) []Violation {
+	if p == nil {
+		return nil
+	}
+
+	var violations []Violation
+
+	if check.Interval != nil && check.TagsKnown {
+		for _, rule := range p.MinIntervals {
+			if rule.Tag != "" && !slices.Contains(check.Tags, rule.Tag) {
+				continue
+			}
+			if *check.Interval < rule.Interval {
+				scope := "Checks"
+				if rule.Tag != "" {
+					scope = fmt.Sprintf("Checks tagged %q", rule.Tag)
+				}
+				violations = append(violations, Violation{
+					Rule:      "min_interval",
+					Attribute: "interval",
+					Level:     rule.Level,
+					Message:   fmt.Sprintf("%s must have an interval of at least %g minutes, got %g.", scope, rule.Interval, *check.Interval),
+				})
+			}
+		}
+	}
+
+	if rule := p.AllowedRunLocations; rule != nil {
+		var denied []string
+		for _, loc := range check.RunLocations {
+			if !slices.Contains(rule.Values, loc) {
+				denied = append(denied, loc)
+			}
+		}
+		if len(denied) > 0 {
+			violations = append(violations, Violation{
+				Rule:      "allowed_runlocations",
+				Attribute: "runlocations",
+				Level:     rule.Level,
+				Message:   fmt.Sprintf("Run locations %s are not allowed. Allowed: %s.", strings.Join(denied, ", "), strings.Join(rule.Values, ", ")),
+			})
+		}
+	}
+
+	if rule := p.RequireNotifications; rule != nil && check.Enabled != nil && *check.Enabled && check.Notifications != nil && *check.Notifications == 0 {
+		violations = append(violations, Violation{
+			Rule:      "require_notifications",
+			Attribute: "notifications",
+			Level:     rule.Level,
+			Message:   "Enabled checks must have at least one notifications block.",
+		})
+	}
+
+	if rule := p.RequiredTags; rule != nil && check.TagsKnown {
+		var missing []string
+		for _, tag := range rule.Tags {
+			if !slices.Contains(check.Tags, tag) {
+				missing = append(missing, tag)
+			}
+		}
+		if len(missing) > 0 {
+			violations = append(violations, Violation{
+				Rule:      "required_tags",
+				Attribute: "tags",
+				Level:     rule.Level,
+				Message:   fmt.Sprintf("Checks must carry the tags %s.", strings.Join(missing, ", ")),
+			})
+		}
+	}
+
+	if rule := p.LabelPattern; rule != nil && check.Label != nil && !rule.Pattern.MatchString(*check.Label) {
+		violations = append(violations, Violation{
+			Rule:      "label_pattern",
+			Attribute: "label",
+			Level:     rule.Level,
+			Message:   fmt.Sprintf("Label %q does not match the pattern %s.", *check.Label, rule.Pattern.String()),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,61 @@
+// Package privatestate stores hashes of secret values in a resource's
+// private state, so that a drifted secret can be detected without keeping
+// the secret itself.
+package privatestate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Store is satisfied by the Private field of the framework's resource
+// request and response types.
+type Store interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// HashSecret returns the hex SHA-256 of a secret value.
+func HashSecret(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetJSON decodes the value stored under key into v. It reports false if
+// nothing is stored or the stored value cannot be decoded, in which case it
+// is ignored with a warning in the log.
+func GetJSON(ctx context.Context, private Store, key string, v interface{}, diags *diag.Diagnostics) bool {
+	data, d := private.GetKey(ctx, key)
+	diags.Append(d...)
+	if len(data) == 0 {
+		return false
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		tflog.Warn(ctx, "Ignoring unreadable private state", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// SetJSON stores v under key.
+func SetJSON(ctx context.Context, private Store, key string, v interface{}, diags *diag.Diagnostics) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		diags.AddError(
+			"Error Storing Private State",
+			"Could not encode "+key+": "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, key, data)...)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
+	checkresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/check"
+)
+
+type CheckDefaultsModel struct {
+	RunLocations  types.List                 `tfsdk:"runlocations"`
+	HomeLoc       types.String               `tfsdk:"homeloc"`
+	Interval      types.Float64              `tfsdk:"interval"`
+	Threshold     types.Int64                `tfsdk:"threshold"`
+	Sens          types.Int64                `tfsdk:"sens"`
+	AutoDiag      types.Bool                 `tfsdk:"autodiag"`
+	WarningDays   types.Int64                `tfsdk:"warningdays"`
+	Notifications []DefaultNotificationModel `tfsdk:"notifications"`
+	TypeOverride  []TypeOverrideModel        `tfsdk:"type_override"`
+}
+
+type TypeOverrideModel struct {
+	Type          types.String               `tfsdk:"type"`
+	RunLocations  types.List                 `tfsdk:"runlocations"`
+	HomeLoc       types.String               `tfsdk:"homeloc"`
+	Interval      types.Float64              `tfsdk:"interval"`
+	Threshold     types.Int64                `tfsdk:"threshold"`
+	Sens          types.Int64                `tfsdk:"sens"`
+	AutoDiag      types.Bool                 `tfsdk:"autodiag"`
+	WarningDays   types.Int64                `tfsdk:"warningdays"`
+	Notifications []DefaultNotificationModel `tfsdk:"notifications"`
+}
+
+type DefaultNotificationModel struct {
+	ContactID types.String `tfsdk:"contact_id"`
+	Delay     types.Int64  `tfsdk:"delay"`
+	Schedule  types.String `tfsdk:"schedule"`
+}
+
+// checkDefaultAttributes are the attributes shared by check_defaults and
+// its type_override blocks.
+func checkDefaultAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"runlocations": schema.ListAttribute{
+			Description: "Default probe locations (region or probe codes).",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"homeloc": schema.StringAttribute{
+			Description: "Default preferred probe location, or 'roam'.",
+			Optional:    true,
+		},
+		"interval": schema.Float64Attribute{
+			Description: "Default check interval in minutes.",
+			Optional:    true,
+		},
+		"threshold": schema.Int64Attribute{
+			Description: "Default timeout in seconds.",
+			Optional:    true,
+		},
+		"sens": schema.Int64Attribute{
+			Description: "Default number of rechecks before a status change.",
+			Optional:    true,
+		},
+		"autodiag": schema.BoolAttribute{
+			Description: "Default for automated diagnostics.",
+			Optional:    true,
+		},
+		"warningdays": schema.Int64Attribute{
+			Description: "Default days before certificate or domain expiration to warn.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func defaultNotificationsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Notifications added to checks that have no notifications blocks of their own.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"contact_id": schema.StringAttribute{
+					Description: "Contact address ID or contact group ID to notify.",
+					Required:    true,
+				},
+				"delay": schema.Int64Attribute{
+					Description: "Delay in minutes before sending the notification. Defaults to 0.",
+					Optional:    true,
+				},
+				"schedule": schema.StringAttribute{
+					Description: "Notification schedule name. Defaults to 'All'.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func checkDefaultsBlock() schema.Block {
+	overrideAttributes := checkDefaultAttributes()
+	overrideAttributes["type"] = schema.StringAttribute{
+		Description: "Check type the override applies to, such as 'SSL'.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(checkresource.ValidCheckTypes...),
+		},
+	}
+
+	return schema.SingleNestedBlock{
+		Description:         "Values applied to nodeping_check attributes that a resource leaves unset. The defaults_applied attribute of each check shows which values came from here.",
+		MarkdownDescription: "Values applied to `nodeping_check` attributes that a resource leaves unset. The `defaults_applied` attribute of each check shows which values came from here.",
+		Attributes:          checkDefaultAttributes(),
+		Blocks: map[string]schema.Block{
+			"notifications": defaultNotificationsBlock(),
+			"type_override": schema.ListNestedBlock{
+				Description: "Defaults for one check type, taking precedence over the values above.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: overrideAttributes,
+					Blocks: map[string]schema.Block{
+						"notifications": defaultNotificationsBlock(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildCheckDefaults converts the check_defaults block into the check
+// resource's defaults. It returns nil when the block is not set.
+func buildCheckDefaults(ctx context.Context, model *CheckDefaultsModel, diags *diag.Diagnostics) *providerdata.CheckDefaults {
+	if model == nil {
+		return nil
+	}
+
+	defaults := &providerdata.CheckDefaults{
+		CheckDefaultValues: checkDefaultValues(ctx, model.RunLocations, model.HomeLoc, model.Interval, model.Threshold, model.Sens, model.AutoDiag, model.WarningDays, model.Notifications, diags),
+		TypeOverrides:      map[string]providerdata.CheckDefaultValues{},
+	}
+
+	for i, o := range model.TypeOverride {
+		checkType := o.Type.ValueString()
+		if _, ok := defaults.TypeOverrides[checkType]; ok {
+			diags.AddAttributeError(
+				path.Root("check_defaults").AtName("type_override").AtListIndex(i).AtName("type"),
+				"Duplicate Check Type Override",
+				"check_defaults has more than one type_override for type "+checkType+".",
+			)
+			continue
+		}
+		defaults.TypeOverrides[checkType] = checkDefaultValues(ctx, o.RunLocations, o.HomeLoc, o.Interval, o.Threshold, o.Sens, o.AutoDiag, o.WarningDays, o.Notifications, diags)
+	}
+
+	return defaults
+}
+
+func checkDefaultValues(ctx context.Context, runLocations types.List, homeLoc types.String, interval types.Float64, threshold, sens types.Int64, autoDiag types.Bool, warningDays types.Int64, notifications []DefaultNotificationModel, diags *diag.Diagnostics) providerdata.CheckDefaultValues {
+	var values providerdata.CheckDefaultValues
+
+	if !runLocations.IsNull() {
+		diags.Append(runLocations.ElementsAs(ctx, &values.RunLocations, false)...)
+	}
+	if !homeLoc.IsNull() {
+		v := homeLoc.ValueString()
+		values.HomeLoc = &v
+	}
+	if !interval.IsNull() {
+		v := interval.ValueFloat64()
+		values.Interval = &v
+	}
+	if !threshold.IsNull() {
+		v := threshold.ValueInt64()
+		values.Threshold = &v
+	}
+	if !sens.IsNull() {
+		v := sens.ValueInt64()
+		values.Sens = &v
+	}
+	if !autoDiag.IsNull() {
+		v := autoDiag.ValueBool()
+		values.AutoDiag = &v
+	}
+	if !warningDays.IsNull() {
+		v := warningDays.ValueInt64()
+		values.WarningDays = &v
+	}
+
+	for _, n := range notifications {
+		schedule := "All"
+		if !n.Schedule.IsNull() {
+			schedule = n.Schedule.ValueString()
+		}
+		values.Notifications = append(values.Notifications, providerdata.NotificationDefault{
+			ContactID: n.ContactID.ValueString(),
+			Delay:     n.Delay.ValueInt64(),
+			Schedule:  schedule,
+		})
+	}
+
+	return values
+}
@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+const testContactID = "201205050153W2Q4C-BKPGH"
+
+// fakeContactAPI serves a single contact, echoing the headers it was sent
+// the way NodePing does.
+type fakeContactAPI struct {
+	mu        sync.Mutex
+	addresses map[string]client.ContactAddress
+	nextID    int
+	updates   []client.ContactUpdateRequest
+}
+
+func newFakeContactAPI(t *testing.T) (*fakeContactAPI, *httptest.Server) {
+	api := &fakeContactAPI{addresses: make(map[string]client.ContactAddress)}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, server
+}
+
+func (api *fakeContactAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/contacts":
+		var req client.ContactCreateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		api.add(req.NewAddresses)
+	case r.Method == http.MethodPut && r.URL.Path == "/contacts/"+testContactID:
+		var req client.ContactUpdateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		api.updates = append(api.updates, req)
+		if req.Addresses != nil {
+			api.addresses = req.Addresses
+		}
+		api.add(req.NewAddresses)
+	case r.Method == http.MethodGet && r.URL.Path == "/contacts/"+testContactID:
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	json.NewEncoder(w).Encode(client.Contact{
+		ID:         testContactID,
+		CustomerID: "201205050153W2Q4C",
+		Name:       "Ops",
+		CustRole:   "notify",
+		Addresses:  api.addresses,
+	})
+}
+
+func (api *fakeContactAPI) add(addresses []client.NewAddress) {
+	for _, addr := range addresses {
+		api.nextID++
+		api.addresses[fmt.Sprintf("ADDR%04d", api.nextID)] = client.ContactAddress{
+			Type:         addr.Type,
+			Address:      addr.Address,
+			Headers:      addr.Headers,
+			QueryStrings: addr.QueryStrings,
+		}
+	}
+}
+
+// contactTestSchema builds contact values for a configured provider.
+type contactTestSchema struct {
+	t           *testing.T
+	contactType tftypes.Type
+	listType    tftypes.Type
+	addressType tftypes.Type
+	secretsType tftypes.Type
+}
+
+func newContactTestSchema(t *testing.T, schemaResp *tfprotov6.GetProviderSchemaResponse) contactTestSchema {
+	contactType := schemaResp.ResourceSchemas["nodeping_contact"].ValueType()
+	listType := contactType.(tftypes.Object).AttributeTypes["address"]
+	addressType := listType.(tftypes.List).ElementType
+	return contactTestSchema{
+		t:           t,
+		contactType: contactType,
+		listType:    listType,
+		addressType: addressType,
+		secretsType: addressType.(tftypes.Object).AttributeTypes["secret_headers"],
+	}
+}
+
+// webhook returns a webhook address block. A non-empty token is set as the
+// write-only Authorization header, as in config.
+func (s contactTestSchema) webhook(key, token string) tftypes.Value {
+	attrs := map[string]tftypes.Value{
+		"key":     tftypes.NewValue(tftypes.String, key),
+		"type":    tftypes.NewValue(tftypes.String, "webhook"),
+		"address": tftypes.NewValue(tftypes.String, "https://hooks.example.com/notify"),
+	}
+	for _, name := range []string{"suppress_up", "suppress_down", "suppress_first", "suppress_diag", "suppress_all", "mute"} {
+		attrs[name] = tftypes.NewValue(tftypes.Bool, false)
+	}
+	attrs["secrets_version"] = tftypes.NewValue(tftypes.Number, 1)
+	if token != "" {
+		attrs["secret_headers"] = tftypes.NewValue(s.secretsType, map[string]tftypes.Value{
+			"Authorization": tftypes.NewValue(tftypes.String, token),
+		})
+	}
+	return objectValue(s.addressType, attrs)
+}
+
+// withID sets the id of an address block, as Terraform core proposes it.
+func (s contactTestSchema) withID(addr tftypes.Value, id tftypes.Value) tftypes.Value {
+	var attrs map[string]tftypes.Value
+	addr.As(&attrs)
+	attrs["id"] = id
+	return tftypes.NewValue(s.addressType, attrs)
+}
+
+func (s contactTestSchema) contact(attrs map[string]tftypes.Value, addresses ...tftypes.Value) *tfprotov6.DynamicValue {
+	all := map[string]tftypes.Value{
+		"name":    tftypes.NewValue(tftypes.String, "Ops"),
+		"address": tftypes.NewValue(s.listType, addresses),
+	}
+	for name, v := range attrs {
+		all[name] = v
+	}
+	return dynamicValue(s.t, s.contactType, objectValue(s.contactType, all))
+}
+
+func (s contactTestSchema) addresses(dv *tfprotov6.DynamicValue) []map[string]tftypes.Value {
+	s.t.Helper()
+	v, err := dv.Unmarshal(s.contactType)
+	if err != nil {
+		s.t.Fatalf("failed to decode contact: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		s.t.Fatal(err)
+	}
+	var list []tftypes.Value
+	if err := attrs["address"].As(&list); err != nil {
+		s.t.Fatal(err)
+	}
+	result := make([]map[string]tftypes.Value, len(list))
+	for i, addr := range list {
+		if err := addr.As(&result[i]); err != nil {
+			s.t.Fatal(err)
+		}
+	}
+	return result
+}
+
+func expectSecretsVersions(t *testing.T, addresses []map[string]tftypes.Value, count int) {
+	t.Helper()
+	if len(addresses) != count {
+		t.Fatalf("expected %d addresses, got %d", count, len(addresses))
+	}
+	for _, addr := range addresses {
+		if !addr["secrets_version"].Equal(tftypes.NewValue(tftypes.Number, 1)) {
+			t.Errorf("expected secrets_version kept for %s, got %s", addr["key"], addr["secrets_version"])
+		}
+	}
+}
+
+// TestContactSecretHashesSameAddress creates two webhooks with the same URL,
+// told apart only by key, each with its own secret header. Neither may be
+// reported as drifted when the API echoes what it was sent.
+func TestContactSecretHashesSameAddress(t *testing.T) {
+	t.Parallel()
+
+	_, server := newFakeContactAPI(t)
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	s := newContactTestSchema(t, schemaResp)
+
+	prior := dynamicValue(t, s.contactType, tftypes.NewValue(s.contactType, nil))
+	proposed := s.contact(nil, s.webhook("primary", ""), s.webhook("backup", ""))
+	config := s.contact(nil, s.webhook("primary", "token-a"), s.webhook("backup", "token-b"))
+
+	created := planAndApply(t, providerServer, "nodeping_contact", prior, proposed, config, nil)
+	expectSecretsVersions(t, s.addresses(created.NewState), 2)
+
+	state := readResource(t, providerServer, "nodeping_contact", created.NewState, created.Private)
+	expectSecretsVersions(t, s.addresses(state), 2)
+}
+
+// TestContactSecretsInsertedAddress inserts an address mid-list. Each
+// address must be sent its own secrets, matched by identity rather than by
+// position in the list.
+func TestContactSecretsInsertedAddress(t *testing.T) {
+	t.Parallel()
+
+	api, server := newFakeContactAPI(t)
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	s := newContactTestSchema(t, schemaResp)
+
+	prior := dynamicValue(t, s.contactType, tftypes.NewValue(s.contactType, nil))
+	created := planAndApply(t, providerServer, "nodeping_contact", prior,
+		s.contact(nil, s.webhook("first", ""), s.webhook("last", "")),
+		s.contact(nil, s.webhook("first", "token-first"), s.webhook("last", "token-last")),
+		nil,
+	)
+
+	createdAddresses := s.addresses(created.NewState)
+	ids := map[string]tftypes.Value{}
+	for _, addr := range createdAddresses {
+		var key string
+		addr["key"].As(&key)
+		ids[key] = addr["id"]
+	}
+
+	stateAttrs := map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, testContactID),
+		"customer_id": tftypes.NewValue(tftypes.String, "201205050153W2Q4C"),
+		"custrole":    tftypes.NewValue(tftypes.String, "notify"),
+	}
+	// Terraform core proposes prior computed values by list index
+	proposed := s.contact(stateAttrs,
+		s.withID(s.webhook("first", ""), ids["first"]),
+		s.withID(s.webhook("inserted", ""), ids["last"]),
+		s.webhook("last", ""),
+	)
+	config := s.contact(nil,
+		s.webhook("first", "token-first"),
+		s.webhook("inserted", "token-inserted"),
+		s.webhook("last", "token-last"),
+	)
+
+	updated := planAndApply(t, providerServer, "nodeping_contact", created.NewState, proposed, config, created.Private)
+
+	api.mu.Lock()
+	update := api.updates[len(api.updates)-1]
+	api.mu.Unlock()
+	var lastID string
+	ids["last"].As(&lastID)
+	if got := update.Addresses[lastID].Headers["Authorization"]; got != "token-last" {
+		t.Errorf("expected the last address to keep its own secret, got %q", got)
+	}
+	if len(update.NewAddresses) != 1 || update.NewAddresses[0].Headers["Authorization"] != "token-inserted" {
+		t.Errorf("expected the inserted address sent with its own secret, got %+v", update.NewAddresses)
+	}
+
+	state := readResource(t, providerServer, "nodeping_contact", updated.NewState, updated.Private)
+	expectSecretsVersions(t, s.addresses(state), 3)
+}
+
+// TestContactAddressReadModifyWrite adds, updates and removes an address
+// with nodeping_contact_address. Every other address on the contact must be
+// sent back exactly as it was read.
+func TestContactAddressReadModifyWrite(t *testing.T) {
+	t.Parallel()
+
+	sibling := client.ContactAddress{
+		Type:         "webhook",
+		Address:      "https://hooks.example.com/notify",
+		SuppressDiag: true,
+		Action:       "post",
+		Headers:      map[string]string{"Authorization": "Bearer sibling"},
+		Data:         `{"text":"down"}`,
+	}
+	api, server := newFakeContactAPI(t)
+	api.addresses["SIBLING1"] = sibling
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+
+	addressType := schemaResp.ResourceSchemas["nodeping_contact_address"].ValueType()
+	address := func(id interface{}, suppressUp bool) *tfprotov6.DynamicValue {
+		attrs := map[string]tftypes.Value{
+			"id":         tftypes.NewValue(tftypes.String, id),
+			"contact_id": tftypes.NewValue(tftypes.String, testContactID),
+			"type":       tftypes.NewValue(tftypes.String, "email"),
+			"address":    tftypes.NewValue(tftypes.String, "oncall@example.com"),
+		}
+		for _, name := range []string{"suppress_down", "suppress_first", "suppress_diag", "suppress_all", "mute"} {
+			attrs[name] = tftypes.NewValue(tftypes.Bool, false)
+		}
+		attrs["suppress_up"] = tftypes.NewValue(tftypes.Bool, suppressUp)
+		return dynamicValue(t, addressType, objectValue(addressType, attrs))
+	}
+	lastUpdate := func() client.ContactUpdateRequest {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		return api.updates[len(api.updates)-1]
+	}
+	expectSibling := func(update client.ContactUpdateRequest) {
+		t.Helper()
+		got, ok := update.Addresses["SIBLING1"]
+		if !ok {
+			t.Fatalf("expected the sibling address sent back, got %+v", update.Addresses)
+		}
+		if got.Type != sibling.Type || got.Address != sibling.Address || !got.SuppressDiag ||
+			got.Action != sibling.Action || got.Headers["Authorization"] != "Bearer sibling" || got.Data != sibling.Data {
+			t.Errorf("expected the sibling address unchanged, got %+v", got)
+		}
+	}
+
+	created := planAndApply(t, providerServer, "nodeping_contact_address",
+		dynamicValue(t, addressType, tftypes.NewValue(addressType, nil)),
+		address(tftypes.UnknownValue, false), address(nil, false), nil)
+	if update := lastUpdate(); update.Addresses != nil || len(update.NewAddresses) != 1 {
+		t.Errorf("expected create to only add the new address, got %+v", update)
+	}
+
+	state, err := created.NewState.Unmarshal(addressType)
+	if err != nil {
+		t.Fatalf("failed to decode state: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := state.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	var id string
+	attrs["id"].As(&id)
+
+	updated := planAndApply(t, providerServer, "nodeping_contact_address",
+		created.NewState, address(id, true), address(nil, true), created.Private)
+	update := lastUpdate()
+	expectSibling(update)
+	if len(update.Addresses) != 2 || !update.Addresses[id].SuppressUp {
+		t.Errorf("expected the updated address next to its sibling, got %+v", update.Addresses)
+	}
+
+	destroyed, err := providerServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "nodeping_contact_address",
+		PriorState:   updated.NewState,
+		PlannedState: dynamicValue(t, addressType, tftypes.NewValue(addressType, nil)),
+		Config:       dynamicValue(t, addressType, tftypes.NewValue(addressType, nil)),
+	})
+	if err != nil {
+		t.Fatalf("failed to destroy: %v", err)
+	}
+	for _, d := range destroyed.Diagnostics {
+		t.Fatalf("unexpected destroy diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	update = lastUpdate()
+	expectSibling(update)
+	if len(update.Addresses) != 1 {
+		t.Errorf("expected only the sibling address left, got %+v", update.Addresses)
+	}
+}
+
+// TestContactExternalAddressWarning adds an address to a managed contact
+// outside Terraform, as nodeping_contact_address would, and expects the
+// plan that removes it to warn.
+func TestContactExternalAddressWarning(t *testing.T) {
+	t.Parallel()
+
+	api, server := newFakeContactAPI(t)
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	s := newContactTestSchema(t, schemaResp)
+
+	config := s.contact(nil, s.webhook("primary", "token-a"))
+	created := planAndApply(t, providerServer, "nodeping_contact",
+		dynamicValue(t, s.contactType, tftypes.NewValue(s.contactType, nil)),
+		s.contact(nil, s.webhook("primary", "")), config, nil)
+
+	proposed := s.contact(map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, testContactID),
+		"customer_id": tftypes.NewValue(tftypes.String, "201205050153W2Q4C"),
+		"custrole":    tftypes.NewValue(tftypes.String, "notify"),
+	}, s.withID(s.webhook("primary", ""), s.addresses(created.NewState)[0]["id"]))
+
+	plan := planResource(t, providerServer, "nodeping_contact", created.NewState, proposed, config, created.Private)
+	if len(plan.Diagnostics) != 0 {
+		t.Fatalf("expected no warning before any address is added elsewhere, got %s", plan.Diagnostics[0].Summary)
+	}
+
+	api.mu.Lock()
+	api.addresses["EXTERNAL"] = client.ContactAddress{Type: "email", Address: "oncall@example.com"}
+	api.mu.Unlock()
+
+	state := readResource(t, providerServer, "nodeping_contact", created.NewState, created.Private)
+	plan = planResource(t, providerServer, "nodeping_contact", state, proposed, config, created.Private)
+	if len(plan.Diagnostics) != 1 || plan.Diagnostics[0].Summary != "Addresses Added Outside This Resource Will Be Removed" {
+		t.Fatalf("expected a warning about the added address, got %v", plan.Diagnostics)
+	}
+	if detail := plan.Diagnostics[0].Detail; !strings.Contains(detail, "oncall@example.com") || strings.Contains(detail, "hooks.example.com") {
+		t.Errorf("expected only the added address named, got %s", detail)
+	}
+}
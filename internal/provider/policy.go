@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/policy"
+)
+
+type PolicyModel struct {
+	MinInterval          []MinIntervalModel         `tfsdk:"min_interval"`
+	AllowedRunLocations  *AllowedRunLocationsModel  `tfsdk:"allowed_runlocations"`
+	RequireNotifications *RequireNotificationsModel `tfsdk:"require_notifications"`
+	RequiredTags         *RequiredTagsModel         `tfsdk:"required_tags"`
+	LabelPattern         *LabelPatternModel         `tfsdk:"label_pattern"`
+}
+
+type MinIntervalModel struct {
+	Tag      types.String  `tfsdk:"tag"`
+	Interval types.Float64 `tfsdk:"interval"`
+	Level    types.String  `tfsdk:"level"`
+}
+
+type AllowedRunLocationsModel struct {
+	Values types.List   `tfsdk:"values"`
+	Level  types.String `tfsdk:"level"`
+}
+
+type RequireNotificationsModel struct {
+	Level types.String `tfsdk:"level"`
+}
+
+type RequiredTagsModel struct {
+	Tags  types.List   `tfsdk:"tags"`
+	Level types.String `tfsdk:"level"`
+}
+
+type LabelPatternModel struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Level   types.String `tfsdk:"level"`
+}
+
+func policyLevelAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         "Whether a violation fails the plan ('error') or is only reported ('warning'). Defaults to 'error'.",
+		MarkdownDescription: "Whether a violation fails the plan (`error`) or is only reported (`warning`). Defaults to `error`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(policy.LevelError, policy.LevelWarning),
+		},
+	}
+}
+
+func policyBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		Description:         "Guardrails evaluated against every nodeping_check at plan time, after default_tags are merged.",
+		MarkdownDescription: "Guardrails evaluated against every `nodeping_check` at plan time, after `default_tags` are merged.",
+		Blocks: map[string]schema.Block{
+			"min_interval": schema.ListNestedBlock{
+				Description: "Shortest interval allowed for checks carrying a tag.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag": schema.StringAttribute{
+							Description: "Tag the rule applies to. Omit to apply it to every check.",
+							Optional:    true,
+						},
+						"interval": schema.Float64Attribute{
+							Description: "Shortest interval allowed, in minutes.",
+							Required:    true,
+						},
+						"level": policyLevelAttribute(),
+					},
+				},
+			},
+			"allowed_runlocations": schema.SingleNestedBlock{
+				Description: "Regions and probes checks may list in runlocations.",
+				Attributes: map[string]schema.Attribute{
+					"values": schema.ListAttribute{
+						Description: "Allowed region or probe codes, such as 'nam' or 'eur'.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"level": policyLevelAttribute(),
+				},
+			},
+			"require_notifications": schema.SingleNestedBlock{
+				Description: "Require at least one notifications block on enabled checks.",
+				Attributes: map[string]schema.Attribute{
+					"level": policyLevelAttribute(),
+				},
+			},
+			"required_tags": schema.SingleNestedBlock{
+				Description: "Tags every check must carry.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.ListAttribute{
+						Description: "The mandatory tags.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"level": policyLevelAttribute(),
+				},
+			},
+			"label_pattern": schema.SingleNestedBlock{
+				Description: "Regular expression every check label must match.",
+				Attributes: map[string]schema.Attribute{
+					"pattern": schema.StringAttribute{
+						Description: "The regular expression, in Go syntax.",
+						Optional:    true,
+					},
+					"level": policyLevelAttribute(),
+				},
+			},
+		},
+	}
+}
+
+// buildPolicy converts the policy block into the check resource's policy. It
+// returns nil when the block is not set.
+func buildPolicy(ctx context.Context, model *PolicyModel, diags *diag.Diagnostics) *policy.Policy {
+	if model == nil {
+		return nil
+	}
+
+	p := &policy.Policy{}
+
+	for _, rule := range model.MinInterval {
+		p.MinIntervals = append(p.MinIntervals, policy.MinIntervalRule{
+			Tag:      rule.Tag.ValueString(),
+			Interval: rule.Interval.ValueFloat64(),
+			Level:    policyLevel(rule.Level),
+		})
+	}
+
+	if rule := model.AllowedRunLocations; rule != nil {
+		var values []string
+		diags.Append(rule.Values.ElementsAs(ctx, &values, false)...)
+		p.AllowedRunLocations = &policy.AllowedRunLocationsRule{
+			Values: values,
+			Level:  policyLevel(rule.Level),
+		}
+	}
+
+	if rule := model.RequireNotifications; rule != nil {
+		p.RequireNotifications = &policy.Rule{
+			Level: policyLevel(rule.Level),
+		}
+	}
+
+	if rule := model.RequiredTags; rule != nil {
+		var tags []string
+		diags.Append(rule.Tags.ElementsAs(ctx, &tags, false)...)
+		p.RequiredTags = &policy.RequiredTagsRule{
+			Tags:  tags,
+			Level: policyLevel(rule.Level),
+		}
+	}
+
+	if rule := model.LabelPattern; rule != nil && !rule.Pattern.IsNull() {
+		pattern, err := regexp.Compile(rule.Pattern.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("policy").AtName("label_pattern").AtName("pattern"),
+				"Invalid Label Pattern",
+				"The policy label_pattern is not a valid regular expression: "+err.Error(),
+			)
+			return nil
+		}
+		p.LabelPattern = &policy.LabelPatternRule{
+			Pattern: pattern,
+			Level:   policyLevel(rule.Level),
+		}
+	}
+
+	return p
+}
+
+func policyLevel(level types.String) string {
+	if level.IsNull() || level.ValueString() == "" {
+		return policy.LevelError
+	}
+	return level.ValueString()
+}
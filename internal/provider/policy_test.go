@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/policy"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	interval := 1.0
+	enabled := true
+	label := "web-api"
+	none := 0
+
+	p := &policy.Policy{
+		MinIntervals: []policy.MinIntervalRule{
+			{Tag: "staging", Interval: 5, Level: policy.LevelWarning},
+			{Tag: "production", Interval: 0.5, Level: policy.LevelError},
+		},
+		AllowedRunLocations:  &policy.AllowedRunLocationsRule{Values: []string{"nam", "eur"}, Level: policy.LevelError},
+		RequireNotifications: &policy.Rule{Level: policy.LevelError},
+		RequiredTags:         &policy.RequiredTagsRule{Tags: []string{"team"}, Level: policy.LevelError},
+		LabelPattern:         &policy.LabelPatternRule{Pattern: regexp.MustCompile(`^[a-z]+\.[a-z]+$`), Level: policy.LevelError},
+	}
+
+	violations := p.Evaluate(policy.Check{
+		Label:         &label,
+		Interval:      &interval,
+		Enabled:       &enabled,
+		Tags:          []string{"staging"},
+		TagsKnown:     true,
+		RunLocations:  []string{"nam", "eao"},
+		Notifications: &none,
+	})
+
+	got := map[string]policy.Violation{}
+	for _, v := range violations {
+		got[v.Rule] = v
+	}
+
+	for _, rule := range []string{"min_interval", "allowed_runlocations", "require_notifications", "required_tags", "label_pattern"} {
+		if _, ok := got[rule]; !ok {
+			t.Errorf("expected %s violation, got %v", rule, violations)
+		}
+	}
+	if len(violations) != 5 {
+		t.Errorf("expected 5 violations, got %d: %v", len(violations), violations)
+	}
+	if got["min_interval"].IsError() {
+		t.Error("expected min_interval violation to be a warning")
+	}
+	if !got["label_pattern"].IsError() {
+		t.Error("expected label_pattern violation to be an error")
+	}
+}
+
+func TestPolicyEvaluateSkipsUnknown(t *testing.T) {
+	p := &policy.Policy{
+		MinIntervals:         []policy.MinIntervalRule{{Interval: 5, Level: policy.LevelError}},
+		RequireNotifications: &policy.Rule{Level: policy.LevelError},
+		RequiredTags:         &policy.RequiredTagsRule{Tags: []string{"team"}, Level: policy.LevelError},
+		LabelPattern:         &policy.LabelPatternRule{Pattern: regexp.MustCompile(`^x$`), Level: policy.LevelError},
+	}
+
+	if violations := p.Evaluate(policy.Check{}); len(violations) != 0 {
+		t.Errorf("expected no violations for unknown values, got %v", violations)
+	}
+}
+
+func TestNilPolicyEvaluate(t *testing.T) {
+	var p *policy.Policy
+	if violations := p.Evaluate(policy.Check{}); violations != nil {
+		t.Errorf("expected no violations, got %v", violations)
+	}
+}
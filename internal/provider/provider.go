@@ -14,12 +14,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/account"
 	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/check"
 	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/checks"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/checkstates"
 	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/contact"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/contactaddress"
 	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/contacts"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/diagnostic"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/notifications"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/slareport"
+	"github.com/nodeping/terraform-provider-nodeping/internal/datasources/unmanagedchecks"
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
 	checkresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/check"
+	checknotificationresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/checknotification"
 	contactresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/contact"
+	contactaddressresource "github.com/nodeping/terraform-provider-nodeping/internal/resources/contactaddress"
 )
 
 var _ provider.Provider = &NodePingProvider{}
@@ -29,14 +39,20 @@ type NodePingProvider struct {
 }
 
 type NodePingProviderModel struct {
-	APIToken     types.String  `tfsdk:"api_token"`
-	CustomerID   types.String  `tfsdk:"customer_id"`
-	APIURL       types.String  `tfsdk:"api_url"`
-	RateLimit    types.Float64 `tfsdk:"rate_limit"`
-	MaxRetries   types.Int64   `tfsdk:"max_retries"`
-	RetryWaitMin types.Int64   `tfsdk:"retry_wait_min"`
-	RetryWaitMax types.Int64   `tfsdk:"retry_wait_max"`
-	DefaultTags  types.List    `tfsdk:"default_tags"`
+	APIToken      types.String        `tfsdk:"api_token"`
+	CustomerID    types.String        `tfsdk:"customer_id"`
+	APIURL        types.String        `tfsdk:"api_url"`
+	RateLimit     types.Float64       `tfsdk:"rate_limit"`
+	MaxRetries    types.Int64         `tfsdk:"max_retries"`
+	RetryWaitMin  types.Int64         `tfsdk:"retry_wait_min"`
+	RetryWaitMax  types.Int64         `tfsdk:"retry_wait_max"`
+	DefaultTags   types.List          `tfsdk:"default_tags"`
+	ManagedTag    types.String        `tfsdk:"managed_tag"`
+	ProtectTags   types.List          `tfsdk:"protect_tags"`
+	MaskPII       types.Bool          `tfsdk:"mask_pii"`
+	PIIKey        types.String        `tfsdk:"pii_fingerprint_key"`
+	Policy        *PolicyModel        `tfsdk:"policy"`
+	CheckDefaults *CheckDefaultsModel `tfsdk:"check_defaults"`
 }
 
 func New(version string) func() provider.Provider {
@@ -112,6 +128,40 @@ resource "nodeping_check" "example" {
 ` + "```" + `
 
 The resulting check will have tags: ` + "`[\"managed-by-terraform\", \"team-devops\", \"production\"]`" + `. Duplicate tags are automatically removed.
+
+## Managed Tag
+
+Set ` + "`managed_tag`" + ` to tag every check the provider manages. The ` + "`nodeping_unmanaged_checks`" + ` data source then lists the checks without it, ready to import:
+
+` + "```hcl" + `
+provider "nodeping" {
+  api_token   = var.nodeping_token
+  managed_tag = "terraform"
+}
+` + "```" + `
+
+## Protected Tags
+
+Checks carrying any of the ` + "`protect_tags`" + ` cannot be destroyed, as if ` + "`deletion_protection`" + ` were set on them:
+
+` + "```hcl" + `
+provider "nodeping" {
+  api_token    = var.nodeping_token
+  protect_tags = ["production"]
+}
+` + "```" + `
+
+## Masking Personal Data
+
+Contact addresses are personal data. Set ` + "`mask_pii`" + ` to redact them in every data source, so they stay out of state, outputs and CI logs. Address IDs, types and, when ` + "`pii_fingerprint_key`" + ` is set, a ` + "`fingerprint`" + ` for equality checks are still exposed:
+
+` + "```hcl" + `
+provider "nodeping" {
+  api_token           = var.nodeping_token
+  mask_pii            = true
+  pii_fingerprint_key = var.pii_fingerprint_key
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
@@ -152,6 +202,32 @@ The resulting check will have tags: ` + "`[\"managed-by-terraform\", \"team-devo
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"managed_tag": schema.StringAttribute{
+				Description:         "Tag added to every check managed by the provider, like default_tags. The nodeping_unmanaged_checks data source lists the checks without it.",
+				MarkdownDescription: "Tag added to every check managed by the provider, like `default_tags`. The `nodeping_unmanaged_checks` data source lists the checks without it.",
+				Optional:            true,
+			},
+			"protect_tags": schema.ListAttribute{
+				Description:         "Checks carrying any of these tags cannot be deleted, as if deletion_protection were set on them. Remove the tag and apply before deleting such a check.",
+				MarkdownDescription: "Checks carrying any of these tags cannot be deleted, as if `deletion_protection` were set on them. Remove the tag and apply before deleting such a check.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pii_fingerprint_key": schema.StringAttribute{
+				Description:         "Secret key for the HMAC-SHA256 address fingerprints exposed by data sources. Fingerprints are null when it is not set, and all of them change when it changes. Can also be set via NODEPING_PII_FINGERPRINT_KEY environment variable.",
+				MarkdownDescription: "Secret key for the HMAC-SHA256 address `fingerprint`s exposed by data sources. Fingerprints are null when it is not set, and all of them change when it changes. Can also be set via `NODEPING_PII_FINGERPRINT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"mask_pii": schema.BoolAttribute{
+				Description:         "Redact contact addresses in all data sources. Address IDs, types and, with pii_fingerprint_key, fingerprints are still exposed. Defaults to false.",
+				MarkdownDescription: "Redact contact addresses in all data sources. Address IDs, types and, with `pii_fingerprint_key`, `fingerprint`s are still exposed. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policy":         policyBlock(),
+			"check_defaults": checkDefaultsBlock(),
 		},
 	}
 }
@@ -222,6 +298,37 @@ func (p *NodePingProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	managedTag := config.ManagedTag.ValueString()
+
+	var protectTags []string
+	if !config.ProtectTags.IsNull() {
+		resp.Diagnostics.Append(config.ProtectTags.ElementsAs(ctx, &protectTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	piiKey := os.Getenv("NODEPING_PII_FINGERPRINT_KEY")
+	if !config.PIIKey.IsNull() {
+		piiKey = config.PIIKey.ValueString()
+	}
+
+	// Masked addresses can only be compared by fingerprint
+	if config.MaskPII.ValueBool() && piiKey == "" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("pii_fingerprint_key"),
+			"Address Fingerprints Disabled",
+			"mask_pii is set but pii_fingerprint_key is not, so data sources return null fingerprints and masked addresses cannot be compared. "+
+				"Set pii_fingerprint_key, or the NODEPING_PII_FINGERPRINT_KEY environment variable, to a stable secret. Fingerprints change whenever the key does.",
+		)
+	}
+
+	policy := buildPolicy(ctx, config.Policy, &resp.Diagnostics)
+	checkDefaults := buildCheckDefaults(ctx, config.CheckDefaults, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clientCfg := client.ClientConfig{
 		APIToken:     apiToken,
 		CustomerID:   customerID,
@@ -232,6 +339,10 @@ func (p *NodePingProvider) Configure(ctx context.Context, req provider.Configure
 		RetryMaxWait: retryWaitMax,
 		UserAgent:    "terraform-provider-nodeping/" + p.version,
 		DefaultTags:  defaultTags,
+		ManagedTag:   managedTag,
+		ProtectTags:  protectTags,
+		MaskPII:      config.MaskPII.ValueBool(),
+		PIIKey:       piiKey,
 	}
 
 	c := client.NewClient(clientCfg)
@@ -244,13 +355,19 @@ func (p *NodePingProvider) Configure(ctx context.Context, req provider.Configure
 	})
 
 	resp.DataSourceData = c
-	resp.ResourceData = c
+	resp.ResourceData = &providerdata.ProviderData{
+		Client:        c,
+		Policy:        policy,
+		CheckDefaults: checkDefaults,
+	}
 }
 
 func (p *NodePingProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		contactresource.NewContactResource,
+		contactaddressresource.NewContactAddressResource,
 		checkresource.NewCheckResource,
+		checknotificationresource.NewCheckNotificationResource,
 	}
 }
 
@@ -258,7 +375,14 @@ func (p *NodePingProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		contact.NewContactDataSource,
 		contacts.NewContactsDataSource,
+		contactaddress.NewContactAddressDataSource,
 		check.NewCheckDataSource,
 		checks.NewChecksDataSource,
+		account.NewAccountDataSource,
+		unmanagedchecks.NewUnmanagedChecksDataSource,
+		notifications.NewNotificationsDataSource,
+		diagnostic.NewDiagnosticDataSource,
+		slareport.NewSLAReportDataSource,
+		checkstates.NewCheckStatesDataSource,
 	}
 }
@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestProviderSchema(t *testing.T) {
@@ -40,3 +50,911 @@ func TestProviderSchema(t *testing.T) {
 		}
 	}
 }
+
+func TestProviderSchemaBlocks(t *testing.T) {
+	t.Parallel()
+
+	resp, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("failed to create provider server: %v", err)
+	}
+
+	schemaResp, err := resp.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema: %v", err)
+	}
+
+	for _, name := range []string{"policy", "check_defaults"} {
+		found := false
+		for _, block := range schemaResp.Provider.Block.BlockTypes {
+			if block.TypeName == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected block %q not found in provider schema", name)
+		}
+	}
+}
+
+// objectValue builds a value of typ with attrs set and every other
+// attribute null.
+func objectValue(typ tftypes.Type, attrs map[string]tftypes.Value) tftypes.Value {
+	vals := make(map[string]tftypes.Value)
+	for name, attrType := range typ.(tftypes.Object).AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		} else {
+			vals[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(typ, vals)
+}
+
+// dynamicValue encodes v as a value of typ.
+func dynamicValue(t *testing.T, typ tftypes.Type, v tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	dv, err := tfprotov6.NewDynamicValue(typ, v)
+	if err != nil {
+		t.Fatalf("failed to encode value: %v", err)
+	}
+	return &dv
+}
+
+// configuredProvider returns a provider server configured against the API
+// at apiURL, with retries off, and the provider's schema.
+func configuredProvider(t *testing.T, apiURL string) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+
+	providerServer, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("failed to create provider server: %v", err)
+	}
+
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema: %v", err)
+	}
+
+	providerType := schemaResp.Provider.ValueType()
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(t, providerType, objectValue(providerType, map[string]tftypes.Value{
+			"api_token":   tftypes.NewValue(tftypes.String, "test-token"),
+			"api_url":     tftypes.NewValue(tftypes.String, apiURL),
+			"max_retries": tftypes.NewValue(tftypes.Number, 0),
+		})),
+	})
+	if err != nil {
+		t.Fatalf("failed to configure provider: %v", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	return providerServer, schemaResp
+}
+
+// planResource plans config against prior and returns the response, which
+// may carry warnings.
+func planResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, prior, proposed, config *tfprotov6.DynamicValue, private []byte) *tfprotov6.PlanResourceChangeResponse {
+	t.Helper()
+	planResp, err := providerServer.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior,
+		PriorPrivate:     private,
+		ProposedNewState: proposed,
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	for _, d := range planResp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected plan error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	return planResp
+}
+
+// planAndApply plans config against prior and applies the plan.
+func planAndApply(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, prior, proposed, config *tfprotov6.DynamicValue, private []byte) *tfprotov6.ApplyResourceChangeResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	planResp := planResource(t, providerServer, typeName, prior, proposed, config, private)
+	for _, d := range planResp.Diagnostics {
+		t.Fatalf("unexpected plan diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	applyResp, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     prior,
+		PlannedState:   planResp.PlannedState,
+		PlannedPrivate: planResp.PlannedPrivate,
+		Config:         config,
+	})
+	if err != nil {
+		t.Fatalf("failed to apply: %v", err)
+	}
+	for _, d := range applyResp.Diagnostics {
+		t.Fatalf("unexpected apply diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	return applyResp
+}
+
+// readResource refreshes state and returns the new state.
+func readResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, state *tfprotov6.DynamicValue, private []byte) *tfprotov6.DynamicValue {
+	t.Helper()
+	resp, err := providerServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: state,
+		Private:      private,
+	})
+	if err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected read diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	return resp.NewState
+}
+
+// TestContactAddressInsertPlan covers why address stays a list block: a
+// set cannot hold the write-only secret attributes, so inserting a block
+// mid-list must still plan only the new address and keep every other
+// address, and its ID, unchanged.
+func TestContactAddressInsertPlan(t *testing.T) {
+	t.Parallel()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("failed to create provider server: %v", err)
+	}
+
+	ctx := context.Background()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema: %v", err)
+	}
+
+	contactType := schemaResp.ResourceSchemas["nodeping_contact"].ValueType()
+	addressListType := contactType.(tftypes.Object).AttributeTypes["address"]
+	addressType := addressListType.(tftypes.List).ElementType
+
+	address := func(id interface{}, addrType, value string, state bool) tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"id":      tftypes.NewValue(tftypes.String, id),
+			"type":    tftypes.NewValue(tftypes.String, addrType),
+			"address": tftypes.NewValue(tftypes.String, value),
+		}
+		if state {
+			for _, name := range []string{"suppress_up", "suppress_down", "suppress_first", "suppress_diag", "suppress_all", "mute"} {
+				attrs[name] = tftypes.NewValue(tftypes.Bool, false)
+			}
+		}
+		return objectValue(addressType, attrs)
+	}
+	contact := func(state bool, addresses ...tftypes.Value) tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, "Ops"),
+			"address": tftypes.NewValue(addressListType, addresses),
+		}
+		if state {
+			attrs["id"] = tftypes.NewValue(tftypes.String, "201205050153W2Q4C")
+			attrs["customer_id"] = tftypes.NewValue(tftypes.String, "201205050153W2Q4C")
+			attrs["custrole"] = tftypes.NewValue(tftypes.String, "notify")
+			attrs["deletion_protection"] = tftypes.NewValue(tftypes.Bool, false)
+		}
+		return objectValue(contactType, attrs)
+	}
+	dynamic := func(v tftypes.Value) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(contactType, v)
+		if err != nil {
+			t.Fatalf("failed to encode value: %v", err)
+		}
+		return &dv
+	}
+
+	prior := contact(true,
+		address("AAAAAAAA", "email", "first@example.com", true),
+		address("BBBBBBBB", "email", "last@example.com", true),
+	)
+	config := contact(false,
+		address(nil, "email", "first@example.com", false),
+		address(nil, "sms", "+15555550100", false),
+		address(nil, "email", "last@example.com", false),
+	)
+	// Terraform core proposes prior computed values by list index, so the
+	// inserted block initially inherits the ID of the address it displaced.
+	proposed := contact(true,
+		address("AAAAAAAA", "email", "first@example.com", true),
+		address("BBBBBBBB", "sms", "+15555550100", true),
+		address(nil, "email", "last@example.com", true),
+	)
+
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "nodeping_contact",
+		PriorState:       dynamic(prior),
+		ProposedNewState: dynamic(proposed),
+		Config:           dynamic(config),
+	})
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	planned, err := resp.PlannedState.Unmarshal(contactType)
+	if err != nil {
+		t.Fatalf("failed to decode plan: %v", err)
+	}
+	var plannedAttrs map[string]tftypes.Value
+	if err := planned.As(&plannedAttrs); err != nil {
+		t.Fatal(err)
+	}
+	var plannedAddresses []tftypes.Value
+	if err := plannedAttrs["address"].As(&plannedAddresses); err != nil {
+		t.Fatal(err)
+	}
+	var priorAttrs map[string]tftypes.Value
+	if err := prior.As(&priorAttrs); err != nil {
+		t.Fatal(err)
+	}
+	var priorAddresses []tftypes.Value
+	if err := priorAttrs["address"].As(&priorAddresses); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plannedAddresses) != 3 {
+		t.Fatalf("expected 3 planned addresses, got %d", len(plannedAddresses))
+	}
+	if !plannedAddresses[0].Equal(priorAddresses[0]) {
+		t.Errorf("expected first address unchanged, got %s", plannedAddresses[0])
+	}
+	if !plannedAddresses[2].Equal(priorAddresses[1]) {
+		t.Errorf("expected last address unchanged, got %s", plannedAddresses[2])
+	}
+
+	var inserted map[string]tftypes.Value
+	if err := plannedAddresses[1].As(&inserted); err != nil {
+		t.Fatal(err)
+	}
+	if inserted["id"].IsKnown() {
+		t.Errorf("expected inserted address to plan a new ID, got %s", inserted["id"])
+	}
+}
+
+// TestCheckQuotaCountsIdenticalPlans plans two new checks that cannot be
+// told apart, as count or for_each instances with an unknown target, and
+// expects both to count against the account's check quota.
+func TestCheckQuotaCountsIdenticalPlans(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/info" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"plan":   "starter",
+			"limits": map[string]interface{}{"checks": 2},
+			"counts": map[string]interface{}{"checks": 1},
+		})
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	ctx := context.Background()
+
+	checkType := schemaResp.ResourceSchemas["nodeping_check"].ValueType()
+	config := dynamicValue(t, checkType, objectValue(checkType, map[string]tftypes.Value{
+		"type":   tftypes.NewValue(tftypes.String, "HTTP"),
+		"target": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}))
+	prior := dynamicValue(t, checkType, tftypes.NewValue(checkType, nil))
+
+	var warnings []string
+	for i := 0; i < 2; i++ {
+		resp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "nodeping_check",
+			PriorState:       prior,
+			ProposedNewState: config,
+			Config:           config,
+		})
+		if err != nil {
+			t.Fatalf("failed to plan: %v", err)
+		}
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityError {
+				t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+			}
+			warnings = append(warnings, d.Summary)
+		}
+	}
+
+	if len(warnings) != 1 || warnings[0] != "Check Quota Exceeded" {
+		t.Errorf("expected a single quota warning on the second plan, got %v", warnings)
+	}
+}
+
+// TestCheckHostPasswordsWriteOnly creates a Redis check with a write-only
+// host password and reads it back. The password must reach the API but only
+// its hash may be kept in private state, and host_passwords_wo_version is
+// cleared once the API echoes a different password.
+func TestCheckHostPasswordsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var sent map[string]interface{}
+	echoed := "s3cret"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/accounts/info":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"limits": map[string]interface{}{"checks": 100},
+				"counts": map[string]interface{}{"checks": 0},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/checks":
+			json.NewDecoder(r.Body).Decode(&sent)
+			fallthrough
+		case r.Method == http.MethodGet && r.URL.Path == "/checks/201205050153W2Q4C-0J2HSIRF":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"_id":    "201205050153W2Q4C-0J2HSIRF",
+				"type":   "REDIS",
+				"label":  "Redis",
+				"enable": "active",
+				"parameters": map[string]interface{}{
+					"hosts": map[string]interface{}{
+						"0": map[string]interface{}{"host": "redis.example.com", "port": 6379, "password": echoed},
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	ctx := context.Background()
+
+	checkType := schemaResp.ResourceSchemas["nodeping_check"].ValueType()
+	passwordsType := checkType.(tftypes.Object).AttributeTypes["host_passwords_wo"]
+	attrs := map[string]tftypes.Value{
+		"type":                      tftypes.NewValue(tftypes.String, "REDIS"),
+		"label":                     tftypes.NewValue(tftypes.String, "Redis"),
+		"extra_parameters_json":     tftypes.NewValue(tftypes.String, `{"hosts":{"0":{"host":"redis.example.com","port":6379}}}`),
+		"host_passwords_wo_version": tftypes.NewValue(tftypes.Number, 1),
+	}
+	proposed := dynamicValue(t, checkType, objectValue(checkType, attrs))
+	attrs["host_passwords_wo"] = tftypes.NewValue(passwordsType, map[string]tftypes.Value{
+		"0": tftypes.NewValue(tftypes.String, "s3cret"),
+	})
+	config := dynamicValue(t, checkType, objectValue(checkType, attrs))
+	prior := dynamicValue(t, checkType, tftypes.NewValue(checkType, nil))
+
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "nodeping_check",
+		PriorState:       prior,
+		ProposedNewState: proposed,
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	for _, d := range planResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	applyResp, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       "nodeping_check",
+		PriorState:     prior,
+		PlannedState:   planResp.PlannedState,
+		PlannedPrivate: planResp.PlannedPrivate,
+		Config:         config,
+	})
+	if err != nil {
+		t.Fatalf("failed to apply: %v", err)
+	}
+	for _, d := range applyResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	host := sent["hosts"].(map[string]interface{})["0"].(map[string]interface{})
+	if host["host"] != "redis.example.com" || host["password"] != "s3cret" {
+		t.Errorf("expected the password merged into the configured host, got %v", host)
+	}
+
+	if bytes.Contains(applyResp.Private, []byte("s3cret")) {
+		t.Fatal("expected private state to hold no plaintext password")
+	}
+	var private map[string][]byte
+	if err := json.Unmarshal(applyResp.Private, &private); err != nil {
+		t.Fatalf("failed to decode private state: %v", err)
+	}
+	sum := sha256.Sum256([]byte("s3cret"))
+	if !bytes.Contains(private["write_only_hashes"], []byte(hex.EncodeToString(sum[:]))) {
+		t.Errorf("expected the password hash in private state, got %s", private["write_only_hashes"])
+	}
+
+	readVersion := func() tftypes.Value {
+		t.Helper()
+		resp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			TypeName:     "nodeping_check",
+			CurrentState: applyResp.NewState,
+			Private:      applyResp.Private,
+		})
+		if err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+		if !bytes.Equal(resp.Private, applyResp.Private) {
+			t.Errorf("expected read to keep private state")
+		}
+		state, err := resp.NewState.Unmarshal(checkType)
+		if err != nil {
+			t.Fatalf("failed to decode state: %v", err)
+		}
+		var stateAttrs map[string]tftypes.Value
+		if err := state.As(&stateAttrs); err != nil {
+			t.Fatal(err)
+		}
+		if !stateAttrs["host_passwords_wo"].IsNull() {
+			t.Errorf("expected no password in state, got %s", stateAttrs["host_passwords_wo"])
+		}
+		var extra string
+		stateAttrs["extra_parameters_json"].As(&extra)
+		if strings.Contains(extra, "password") {
+			t.Errorf("expected no password in extra_parameters_json, got %s", extra)
+		}
+		return stateAttrs["host_passwords_wo_version"]
+	}
+
+	if version := readVersion(); !version.Equal(tftypes.NewValue(tftypes.Number, 1)) {
+		t.Errorf("expected version kept while the password matches, got %s", version)
+	}
+
+	mu.Lock()
+	echoed = "changed"
+	mu.Unlock()
+
+	if version := readVersion(); !version.IsNull() {
+		t.Errorf("expected version cleared after drift, got %s", version)
+	}
+}
+
+// TestCheckExtraParametersRemoved removes extra_parameters_json from the
+// configuration and expects every key it used to set to be cleared on the
+// API rather than kept as the computed value.
+func TestCheckExtraParametersRemoved(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var updated map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		parameters := map[string]interface{}{"target": "8.8.8.8", "dnstype": "A"}
+		switch {
+		case r.URL.Path == "/accounts/info":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"limits": map[string]interface{}{"checks": 100},
+				"counts": map[string]interface{}{"checks": 0},
+			})
+			return
+		case r.Method == http.MethodPost && r.URL.Path == "/checks":
+			parameters["edns"] = map[string]interface{}{"nsid": ""}
+		case r.Method == http.MethodPut && r.URL.Path == "/checks/201205050153W2Q4C-0J2HSIRF":
+			json.NewDecoder(r.Body).Decode(&updated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"_id":        "201205050153W2Q4C-0J2HSIRF",
+			"type":       "DNS",
+			"label":      "DNS",
+			"enable":     "active",
+			"parameters": parameters,
+		})
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	checkType := schemaResp.ResourceSchemas["nodeping_check"].ValueType()
+
+	attrs := map[string]tftypes.Value{
+		"type":    tftypes.NewValue(tftypes.String, "DNS"),
+		"label":   tftypes.NewValue(tftypes.String, "DNS"),
+		"target":  tftypes.NewValue(tftypes.String, "8.8.8.8"),
+		"dnstype": tftypes.NewValue(tftypes.String, "A"),
+	}
+	attrs["extra_parameters_json"] = tftypes.NewValue(tftypes.String, `{"edns":{"nsid":""}}`)
+	config := dynamicValue(t, checkType, objectValue(checkType, attrs))
+	created := planAndApply(t, providerServer, "nodeping_check",
+		dynamicValue(t, checkType, tftypes.NewValue(checkType, nil)), config, config, nil)
+
+	// Without extra_parameters_json, Terraform core proposes its prior value
+	state, err := created.NewState.Unmarshal(checkType)
+	if err != nil {
+		t.Fatalf("failed to decode state: %v", err)
+	}
+	delete(attrs, "extra_parameters_json")
+	config = dynamicValue(t, checkType, objectValue(checkType, attrs))
+	planAndApply(t, providerServer, "nodeping_check", created.NewState, dynamicValue(t, checkType, state), config, created.Private)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if value, ok := updated["edns"]; !ok || value != nil {
+		t.Errorf("expected edns cleared with null, got %v", updated)
+	}
+}
+
+// TestCheckExternalNotificationWarning attaches a notification to a check
+// outside Terraform, as nodeping_check_notification would, and expects the
+// plan that removes it to warn while manage_notifications is on.
+func TestCheckExternalNotificationWarning(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var notifications []interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/accounts/info":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"limits": map[string]interface{}{"checks": 100},
+				"counts": map[string]interface{}{"checks": 0},
+			})
+			return
+		case r.URL.Path == "/contacts":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"201205050153W2Q4C-BKPGH": map[string]interface{}{
+					"_id": "201205050153W2Q4C-BKPGH",
+					"addresses": map[string]interface{}{
+						"ADDRTERRAFORM": map[string]interface{}{"type": "email", "address": "ops@example.com"},
+						"ADDRELSEWHERE": map[string]interface{}{"type": "email", "address": "oncall@example.com"},
+					},
+				},
+			})
+			return
+		case r.URL.Path == "/contactgroups" || r.URL.Path == "/schedules":
+			w.Write([]byte("{}"))
+			return
+		case r.Method == http.MethodPost && r.URL.Path == "/checks":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			notifications, _ = body["notifications"].([]interface{})
+		case r.Method == http.MethodGet && r.URL.Path == "/checks/201205050153W2Q4C-0J2HSIRF":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"_id":           "201205050153W2Q4C-0J2HSIRF",
+			"type":          "PING",
+			"label":         "Ping",
+			"enable":        "active",
+			"parameters":    map[string]interface{}{"target": "example.com"},
+			"notifications": notifications,
+		})
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	checkType := schemaResp.ResourceSchemas["nodeping_check"].ValueType()
+	notificationsType := checkType.(tftypes.Object).AttributeTypes["notifications"]
+	notificationType := notificationsType.(tftypes.List).ElementType
+
+	attrs := map[string]tftypes.Value{
+		"type":   tftypes.NewValue(tftypes.String, "PING"),
+		"label":  tftypes.NewValue(tftypes.String, "Ping"),
+		"target": tftypes.NewValue(tftypes.String, "example.com"),
+		"notifications": tftypes.NewValue(notificationsType, []tftypes.Value{
+			objectValue(notificationType, map[string]tftypes.Value{
+				"contact_id": tftypes.NewValue(tftypes.String, "ADDRTERRAFORM"),
+				"delay":      tftypes.NewValue(tftypes.Number, 0),
+				"schedule":   tftypes.NewValue(tftypes.String, "All"),
+			}),
+		}),
+	}
+	config := dynamicValue(t, checkType, objectValue(checkType, attrs))
+	created := planAndApply(t, providerServer, "nodeping_check",
+		dynamicValue(t, checkType, tftypes.NewValue(checkType, nil)), config, config, nil)
+
+	mu.Lock()
+	notifications = append(notifications, map[string]interface{}{
+		"ADDRELSEWHERE": map[string]interface{}{"delay": 5, "schedule": "All"},
+	})
+	mu.Unlock()
+
+	state := readResource(t, providerServer, "nodeping_check", created.NewState, created.Private)
+	proposed, err := created.NewState.Unmarshal(checkType)
+	if err != nil {
+		t.Fatalf("failed to decode state: %v", err)
+	}
+	plan := planResource(t, providerServer, "nodeping_check", state, dynamicValue(t, checkType, proposed), config, created.Private)
+
+	var warnings []*tfprotov6.Diagnostic
+	for _, d := range plan.Diagnostics {
+		if d.Summary == "Notifications Added Outside This Resource Will Be Removed" {
+			warnings = append(warnings, d)
+		}
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected a warning about the attached notification, got %v", plan.Diagnostics)
+	}
+	if detail := warnings[0].Detail; !strings.Contains(detail, "ADDRELSEWHERE") || strings.Contains(detail, "ADDRTERRAFORM") {
+		t.Errorf("expected only the attached notification named, got %s", detail)
+	}
+}
+
+// TestCheckUpdateWaitsOnlyOnRunChanges updates a check with wait_for_status
+// set. Changing the label must not wait for a result, while changing the
+// target must.
+func TestCheckUpdateWaitsOnlyOnRunChanges(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var resultReads int
+	target := "example.com"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/accounts/info":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"limits": map[string]interface{}{"checks": 100},
+				"counts": map[string]interface{}{"checks": 0},
+			})
+			return
+		case r.URL.Path == "/results/201205050153W2Q4C-0J2HSIRF":
+			resultReads++
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"s": time.Now().Add(time.Minute).UnixMilli(), "su": true},
+			})
+			return
+		case r.URL.Path == "/checks" || r.URL.Path == "/checks/201205050153W2Q4C-0J2HSIRF":
+			if r.Method != http.MethodGet {
+				var body map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&body)
+				target, _ = body["target"].(string)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"_id":        "201205050153W2Q4C-0J2HSIRF",
+			"type":       "PING",
+			"label":      "Ping",
+			"enable":     "active",
+			"parameters": map[string]interface{}{"target": target},
+		})
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+	checkType := schemaResp.ResourceSchemas["nodeping_check"].ValueType()
+
+	attrs := map[string]tftypes.Value{
+		"type":            tftypes.NewValue(tftypes.String, "PING"),
+		"label":           tftypes.NewValue(tftypes.String, "Ping"),
+		"target":          tftypes.NewValue(tftypes.String, "example.com"),
+		"enabled":         tftypes.NewValue(tftypes.Bool, true),
+		"wait_for_status": tftypes.NewValue(tftypes.String, "any"),
+		"wait_timeout":    tftypes.NewValue(tftypes.String, "5s"),
+	}
+	config := dynamicValue(t, checkType, objectValue(checkType, attrs))
+	applied := planAndApply(t, providerServer, "nodeping_check",
+		dynamicValue(t, checkType, tftypes.NewValue(checkType, nil)), config, config, nil)
+
+	update := func(name, value string) {
+		t.Helper()
+		state, err := applied.NewState.Unmarshal(checkType)
+		if err != nil {
+			t.Fatalf("failed to decode state: %v", err)
+		}
+		var stateAttrs map[string]tftypes.Value
+		if err := state.As(&stateAttrs); err != nil {
+			t.Fatal(err)
+		}
+		stateAttrs[name] = tftypes.NewValue(tftypes.String, value)
+		attrs[name] = tftypes.NewValue(tftypes.String, value)
+
+		mu.Lock()
+		resultReads = 0
+		mu.Unlock()
+		applied = planAndApply(t, providerServer, "nodeping_check", applied.NewState,
+			dynamicValue(t, checkType, tftypes.NewValue(checkType, stateAttrs)),
+			dynamicValue(t, checkType, objectValue(checkType, attrs)), applied.Private)
+	}
+
+	update("label", "Ping renamed")
+	mu.Lock()
+	if resultReads != 0 {
+		t.Errorf("expected a label change not to wait for a result, got %d result reads", resultReads)
+	}
+	mu.Unlock()
+
+	update("target", "example.org")
+	mu.Lock()
+	if resultReads == 0 {
+		t.Errorf("expected a target change to wait for a result")
+	}
+	mu.Unlock()
+}
+
+// TestCheckDataSourceParameters covers the parameters the check resource
+// keeps from configuration: the data source still reads them from the API,
+// marks sendheaders sensitive and leaves secrets out of
+// extra_parameters_json.
+func TestCheckDataSourceParameters(t *testing.T) {
+	t.Parallel()
+
+	const checkID = "201205050153W2Q4C-0J2HSIRF"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/checks/"+checkID {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"_id": "` + checkID + `",
+			"type": "HTTPADV",
+			"label": "api",
+			"enable": "active",
+			"homeloc": false,
+			"parameters": {
+				"target": "https://example.com",
+				"postdata": "a=1",
+				"secure": "ssl",
+				"dnssection": "answer",
+				"transport": "udp",
+				"email": "ops@example.com",
+				"database": "app",
+				"query": "SELECT 1",
+				"namespace": "app.users",
+				"snmpv": "2c",
+				"sendheaders": {"Authorization": "Bearer secret"},
+				"hosts": {"primary": {"host": "10.0.0.1", "port": 6379, "password": "hunter2"}}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	providerServer, schemaResp := configuredProvider(t, server.URL)
+
+	dataSchema := schemaResp.DataSourceSchemas["nodeping_check"]
+	sensitive := map[string]bool{}
+	for _, attr := range dataSchema.Block.Attributes {
+		sensitive[attr.Name] = attr.Sensitive
+	}
+	for _, name := range []string{"sendheaders", "extra_parameters_json"} {
+		if !sensitive[name] {
+			t.Errorf("expected %s to be sensitive", name)
+		}
+	}
+
+	typ := dataSchema.ValueType()
+	resp, err := providerServer.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+		TypeName: "nodeping_check",
+		Config: dynamicValue(t, typ, objectValue(typ, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, checkID),
+		})),
+	})
+	if err != nil {
+		t.Fatalf("failed to read data source: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected read diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := resp.State.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("failed to decode state: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := state.As(&attrs); err != nil {
+		t.Fatalf("failed to decode state: %v", err)
+	}
+
+	want := map[string]string{
+		"postdata":   "a=1",
+		"secure":     "ssl",
+		"dnssection": "answer",
+		"transport":  "udp",
+		"email":      "ops@example.com",
+		"database":   "app",
+		"query":      "SELECT 1",
+		"namespace":  "app.users",
+		"snmpv":      "2c",
+	}
+	for name, value := range want {
+		var got string
+		if err := attrs[name].As(&got); err != nil || got != value {
+			t.Errorf("expected %s %q, got %v", name, value, attrs[name])
+		}
+	}
+	if !attrs["homeloc"].IsNull() {
+		t.Errorf("expected homeloc null when the API returns false, got %v", attrs["homeloc"])
+	}
+
+	var extra string
+	if err := attrs["extra_parameters_json"].As(&extra); err != nil {
+		t.Fatalf("failed to decode extra_parameters_json: %v", err)
+	}
+	if !strings.Contains(extra, `"host":"10.0.0.1"`) || strings.Contains(extra, "hunter2") {
+		t.Errorf("expected hosts without their password, got %s", extra)
+	}
+}
+
+// TestMaskPIIWithoutFingerprintKey covers the warning for mask_pii without
+// pii_fingerprint_key, where masked addresses cannot be compared.
+func TestMaskPIIWithoutFingerprintKey(t *testing.T) {
+	t.Setenv("NODEPING_PII_FINGERPRINT_KEY", "")
+
+	for _, tt := range []struct {
+		name        string
+		key         interface{}
+		wantWarning bool
+	}{
+		{name: "without key", key: nil, wantWarning: true},
+		{name: "with key", key: "fingerprint-key", wantWarning: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			providerServer, err := providerserver.NewProtocol6WithError(New("test")())()
+			if err != nil {
+				t.Fatalf("failed to create provider server: %v", err)
+			}
+
+			ctx := context.Background()
+			schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatalf("failed to get provider schema: %v", err)
+			}
+
+			providerType := schemaResp.Provider.ValueType()
+			configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+				Config: dynamicValue(t, providerType, objectValue(providerType, map[string]tftypes.Value{
+					"api_token":           tftypes.NewValue(tftypes.String, "test-token"),
+					"mask_pii":            tftypes.NewValue(tftypes.Bool, true),
+					"pii_fingerprint_key": tftypes.NewValue(tftypes.String, tt.key),
+				})),
+			})
+			if err != nil {
+				t.Fatalf("failed to configure provider: %v", err)
+			}
+
+			warned := false
+			for _, d := range configureResp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+				}
+				if d.Summary == "Address Fingerprints Disabled" {
+					warned = true
+				}
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("expected warning %v, got %v", tt.wantWarning, warned)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package providerdata
+
+// CheckDefaults holds provider-level values for check attributes that a
+// resource leaves unset. TypeOverrides, keyed by check type, take
+// precedence over the base values.
+type CheckDefaults struct {
+	CheckDefaultValues
+	TypeOverrides map[string]CheckDefaultValues
+}
+
+// CheckDefaultValues is one set of defaults. Nil fields have no default.
+type CheckDefaultValues struct {
+	RunLocations  []string
+	HomeLoc       *string
+	Interval      *float64
+	Threshold     *int64
+	Sens          *int64
+	AutoDiag      *bool
+	WarningDays   *int64
+	Notifications []NotificationDefault
+}
+
+// NotificationDefault is a notification added to checks that declare none.
+type NotificationDefault struct {
+	ContactID string
+	Delay     int64
+	Schedule  string
+}
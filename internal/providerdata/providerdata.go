@@ -0,0 +1,16 @@
+// Package providerdata defines what the provider hands its resources when
+// it is configured.
+package providerdata
+
+import (
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/policy"
+)
+
+// ProviderData is the resource data set by the provider's Configure. Policy
+// and CheckDefaults are nil when their provider blocks are not set.
+type ProviderData struct {
+	Client        *client.Client
+	Policy        *policy.Policy
+	CheckDefaults *CheckDefaults
+}
@@ -0,0 +1,70 @@
+package check
+
+import (
+	"context"
+	"time"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+// Values of on_destroy.
+const (
+	onDestroyDelete        = "delete"
+	onDestroyDisable       = "disable"
+	onDestroyDisableAndTag = "disable_and_tag"
+)
+
+// decommissionedTag marks checks that were disabled instead of deleted. A
+// second tag records when, since tags are the only free-form field that
+// survives on a disabled check without touching its description.
+const decommissionedTag = "decommissioned"
+
+// onDestroyMode returns the configured on_destroy mode. Unset counts as
+// delete, the default.
+func onDestroyMode(model CheckResourceModel) string {
+	if model.OnDestroy.IsNull() || model.OnDestroy.IsUnknown() {
+		return onDestroyDelete
+	}
+	return model.OnDestroy.ValueString()
+}
+
+// decommissionCheck disables a check instead of deleting it, keeping its
+// results and uptime history. With tag set, the check is also tagged as
+// decommissioned along with a UTC timestamp. Existing tags are kept.
+func (r *CheckResource) decommissionCheck(ctx context.Context, id string, tag bool) error {
+	unlock := r.client.Lock("check", id)
+	defer unlock()
+
+	check, err := r.client.GetCheck(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	req := client.CheckCreateRequest{
+		Type:    check.Type,
+		Enabled: "false",
+	}
+	if tag {
+		req.Tags = decommissionTags(check.Tags, time.Now())
+	}
+
+	_, err = r.client.UpdateCheck(ctx, id, client.CheckUpdateRequest{CheckCreateRequest: req})
+	return err
+}
+
+// decommissionTags appends the decommissioned tags to tags, without
+// repeating the plain marker if a previous decommission left it behind.
+func decommissionTags(tags []string, now time.Time) []string {
+	result := make([]string, 0, len(tags)+2)
+	marked := false
+	for _, t := range tags {
+		if t == decommissionedTag {
+			marked = true
+		}
+		result = append(result, t)
+	}
+	if !marked {
+		result = append(result, decommissionedTag)
+	}
+	return append(result, decommissionedTag+":"+now.UTC().Format(time.RFC3339))
+}
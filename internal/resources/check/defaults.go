@@ -0,0 +1,207 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
+)
+
+// Sources recorded in defaults_applied.
+const (
+	defaultsSource             = "check_defaults"
+	defaultsTypeOverrideSource = "check_defaults.type_override."
+)
+
+var notificationAttrTypes = map[string]attr.Type{
+	"contact_id": types.StringType,
+	"delay":      types.Int64Type,
+	"schedule":   types.StringType,
+}
+
+// applyCheckDefaults fills the attributes the configuration leaves unset
+// from the provider's check_defaults, preferring the type_override of the
+// check's type, and records where each value came from. Attributes with no
+// default keep their schema default, or stay null.
+func (r *CheckResource) applyCheckDefaults(ctx context.Context, config CheckResourceModel, plan *CheckResourceModel, diags *diag.Diagnostics) {
+	applied := map[string]attr.Value{}
+
+	defaults := r.defaults
+	var base, override providerdata.CheckDefaultValues
+	overrideSource := ""
+	if defaults != nil {
+		base = defaults.CheckDefaultValues
+		if !plan.Type.IsUnknown() {
+			if o, ok := defaults.TypeOverrides[plan.Type.ValueString()]; ok {
+				override = o
+				overrideSource = defaultsTypeOverrideSource + plan.Type.ValueString()
+			}
+		}
+	}
+
+	// pick returns the source of the default for an attribute, preferring
+	// the type override, or "" when neither sets it.
+	pick := func(inOverride, inBase bool) string {
+		switch {
+		case inOverride:
+			return overrideSource
+		case inBase:
+			return defaultsSource
+		}
+		return ""
+	}
+
+	if config.RunLocations.IsNull() {
+		plan.RunLocations = types.ListNull(types.StringType)
+		if source := pick(override.RunLocations != nil, base.RunLocations != nil); source != "" {
+			values := base.RunLocations
+			if source == overrideSource {
+				values = override.RunLocations
+			}
+			list, d := types.ListValueFrom(ctx, types.StringType, values)
+			diags.Append(d...)
+			plan.RunLocations = list
+			applied["runlocations"] = types.StringValue(source)
+		}
+	}
+
+	if config.HomeLoc.IsNull() {
+		plan.HomeLoc = types.StringNull()
+		if source := pick(override.HomeLoc != nil, base.HomeLoc != nil); source != "" {
+			v := base.HomeLoc
+			if source == overrideSource {
+				v = override.HomeLoc
+			}
+			plan.HomeLoc = types.StringValue(*v)
+			applied["homeloc"] = types.StringValue(source)
+		}
+	}
+
+	if config.Interval.IsNull() {
+		if source := pick(override.Interval != nil, base.Interval != nil); source != "" {
+			v := base.Interval
+			if source == overrideSource {
+				v = override.Interval
+			}
+			plan.Interval = types.Float64Value(*v)
+			applied["interval"] = types.StringValue(source)
+		}
+	}
+
+	if config.Threshold.IsNull() {
+		if source := pick(override.Threshold != nil, base.Threshold != nil); source != "" {
+			v := base.Threshold
+			if source == overrideSource {
+				v = override.Threshold
+			}
+			plan.Threshold = types.Int64Value(*v)
+			applied["threshold"] = types.StringValue(source)
+		}
+	}
+
+	if config.Sens.IsNull() {
+		if source := pick(override.Sens != nil, base.Sens != nil); source != "" {
+			v := base.Sens
+			if source == overrideSource {
+				v = override.Sens
+			}
+			plan.Sens = types.Int64Value(*v)
+			applied["sens"] = types.StringValue(source)
+		}
+	}
+
+	if config.AutoDiag.IsNull() {
+		if source := pick(override.AutoDiag != nil, base.AutoDiag != nil); source != "" {
+			v := base.AutoDiag
+			if source == overrideSource {
+				v = override.AutoDiag
+			}
+			plan.AutoDiag = types.BoolValue(*v)
+			applied["autodiag"] = types.StringValue(source)
+		}
+	}
+
+	if config.WarningDays.IsNull() {
+		plan.WarningDays = types.Int64Null()
+		if source := pick(override.WarningDays != nil, base.WarningDays != nil); source != "" {
+			v := base.WarningDays
+			if source == overrideSource {
+				v = override.WarningDays
+			}
+			plan.WarningDays = types.Int64Value(*v)
+			applied["warningdays"] = types.StringValue(source)
+		}
+	}
+
+	plan.DefaultNotifications = types.ListNull(types.ObjectType{AttrTypes: notificationAttrTypes})
+	if notificationsManaged(*plan) && len(config.Notifications) == 0 {
+		if source := pick(len(override.Notifications) > 0, len(base.Notifications) > 0); source != "" {
+			values := base.Notifications
+			if source == overrideSource {
+				values = override.Notifications
+			}
+			plan.DefaultNotifications = defaultNotificationsValue(values, diags)
+			applied["notifications"] = types.StringValue(source)
+		}
+	}
+
+	appliedMap, d := types.MapValue(types.StringType, applied)
+	diags.Append(d...)
+	plan.DefaultsApplied = appliedMap
+}
+
+func defaultNotificationsValue(notifications []providerdata.NotificationDefault, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, 0, len(notifications))
+	for _, n := range notifications {
+		obj, d := types.ObjectValue(notificationAttrTypes, map[string]attr.Value{
+			"contact_id": types.StringValue(n.ContactID),
+			"delay":      types.Int64Value(n.Delay),
+			"schedule":   types.StringValue(n.Schedule),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: notificationAttrTypes}, elems)
+	diags.Append(d...)
+	return list
+}
+
+// defaultNotificationModels returns the planned default notifications, or
+// nil when none apply.
+func defaultNotificationModels(ctx context.Context, model CheckResourceModel, diags *diag.Diagnostics) []NotificationModel {
+	if model.DefaultNotifications.IsNull() || model.DefaultNotifications.IsUnknown() {
+		return nil
+	}
+	var notifications []NotificationModel
+	diags.Append(model.DefaultNotifications.ElementsAs(ctx, &notifications, false)...)
+	return notifications
+}
+
+// usesDefaultNotifications reports whether the check's notifications come
+// from check_defaults rather than notifications blocks.
+func usesDefaultNotifications(model CheckResourceModel) bool {
+	return !model.DefaultNotifications.IsNull() && !model.DefaultNotifications.IsUnknown() && len(model.DefaultNotifications.Elements()) > 0
+}
+
+// resolveUnknownDefaults settles the values that stay unknown when the plan
+// was made without a configured provider, once the API has answered.
+func resolveUnknownDefaults(model *CheckResourceModel) {
+	if model.HomeLoc.IsUnknown() {
+		model.HomeLoc = types.StringNull()
+	}
+	if model.RunLocations.IsUnknown() {
+		model.RunLocations = types.ListNull(types.StringType)
+	}
+	if model.WarningDays.IsUnknown() {
+		model.WarningDays = types.Int64Null()
+	}
+	if model.DefaultNotifications.IsUnknown() {
+		model.DefaultNotifications = types.ListNull(types.ObjectType{AttrTypes: notificationAttrTypes})
+	}
+	if model.DefaultsApplied.IsUnknown() {
+		model.DefaultsApplied = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+}
@@ -0,0 +1,202 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/planmodifiers"
+	"github.com/nodeping/terraform-provider-nodeping/internal/privatestate"
+)
+
+// privateKeyExtraParameters is the private state key holding the top-level
+// keys of the extra_parameters_json last sent from configuration.
+const privateKeyExtraParameters = "extra_parameters_keys"
+
+// modeledParameters lists the check parameters that have a dedicated schema
+// attribute. Everything else the API returns is only reachable through
+// extra_parameters_json.
+var modeledParameters = map[string]bool{
+	"target": true, "threshold": true, "sens": true, "contentstring": true,
+	"regex": true, "invert": true, "follow": true, "method": true,
+	"statuscode": true, "sendheaders": true, "receiveheaders": true,
+	"postdata": true, "port": true, "username": true, "password": true,
+	"secure": true, "verify": true, "ipv6": true, "dnstype": true,
+	"dnstoresolve": true, "dnssection": true, "dnsrd": true, "transport": true,
+	"warningdays": true, "servername": true, "email": true, "database": true,
+	"query": true, "namespace": true, "sshkey": true, "clientcert": true,
+	"snmpv": true, "snmpcom": true,
+}
+
+// applyExtraParameters decodes extra_parameters_json so the client deep-merges
+// it into the request body after the modeled attributes.
+func applyExtraParameters(plan *CheckResourceModel, req *client.CheckCreateRequest, diags *diag.Diagnostics) {
+	if plan.ExtraParametersJSON.IsNull() || plan.ExtraParametersJSON.IsUnknown() {
+		return
+	}
+
+	var extra map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.ExtraParametersJSON.ValueString()), &extra); err != nil {
+		diags.AddAttributeError(
+			path.Root("extra_parameters_json"),
+			"Invalid Extra Parameters",
+			"extra_parameters_json must be a JSON object: "+err.Error(),
+		)
+		return
+	}
+
+	req.ExtraParameters = extra
+}
+
+// planExtraParametersRemoval plans extra_parameters_json as unknown once it
+// is removed from a configuration that used to set it. Being computed, it
+// would otherwise keep its last value and the parameters would stay set.
+func planExtraParametersRemoval(ctx context.Context, private privatestate.Store, config CheckResourceModel, plan *CheckResourceModel, diags *diag.Diagnostics) {
+	if !config.ExtraParametersJSON.IsNull() {
+		return
+	}
+	if len(configuredExtraKeys(ctx, private, diags)) > 0 {
+		plan.ExtraParametersJSON = types.StringUnknown()
+	}
+}
+
+// clearRemovedExtraParameters sends null for every top-level key that the
+// removed extra_parameters_json used to set, so NodePing drops them.
+func clearRemovedExtraParameters(ctx context.Context, private privatestate.Store, plan *CheckResourceModel, req *client.CheckCreateRequest, diags *diag.Diagnostics) {
+	if !plan.ExtraParametersJSON.IsUnknown() {
+		return
+	}
+	for _, key := range configuredExtraKeys(ctx, private, diags) {
+		if req.ExtraParameters == nil {
+			req.ExtraParameters = make(map[string]interface{})
+		}
+		if _, set := req.ExtraParameters[key]; !set {
+			req.ExtraParameters[key] = nil
+		}
+	}
+}
+
+func configuredExtraKeys(ctx context.Context, private privatestate.Store, diags *diag.Diagnostics) []string {
+	var keys []string
+	privatestate.GetJSON(ctx, private, privateKeyExtraParameters, &keys, diags)
+	return keys
+}
+
+// setConfiguredExtraKeys records the top-level keys sent from a configured
+// extra_parameters_json, or removes the record when it is not configured.
+func setConfiguredExtraKeys(ctx context.Context, private privatestate.Store, configured types.String, diags *diag.Diagnostics) {
+	var keys []string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		var extra map[string]interface{}
+		if err := json.Unmarshal([]byte(configured.ValueString()), &extra); err == nil {
+			for key := range extra {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+		}
+	}
+	if len(keys) == 0 {
+		diags.Append(private.SetKey(ctx, privateKeyExtraParameters, nil)...)
+		return
+	}
+	privatestate.SetJSON(ctx, private, privateKeyExtraParameters, keys, diags)
+}
+
+// readExtraParameters returns extra_parameters_json as read from the API.
+// A null prior value, as on import, is filled with every unmodeled
+// parameter. A set value keeps tracking only its own top-level keys, so
+// configuring some keys never shows the others as drift.
+func readExtraParameters(check *client.Check, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return unmodeledParameters(check)
+	}
+	return refreshExtraParameters(check, prior)
+}
+
+// refreshExtraParameters re-reads the keys already tracked in
+// extra_parameters_json from the API. The prior value is kept when it is
+// semantically equal, so formatting differences never show up as drift.
+func refreshExtraParameters(check *client.Check, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+
+	var tracked map[string]interface{}
+	if err := json.Unmarshal([]byte(prior.ValueString()), &tracked); err != nil {
+		return prior
+	}
+
+	current := make(map[string]interface{}, len(tracked))
+	redacted := make(map[string]interface{}, len(tracked))
+	for key, value := range tracked {
+		redacted[key] = withoutSecrets(key, value)
+		if value, ok := check.Parameters.Raw[key]; ok {
+			current[key] = withoutSecrets(key, value)
+		}
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return prior
+	}
+	// Secrets are never read back, so they are left out of the comparison
+	priorData, err := json.Marshal(redacted)
+	if err != nil {
+		return prior
+	}
+	if planmodifiers.JSONSemanticEqualValues(string(priorData), string(data)) {
+		return prior
+	}
+	return types.StringValue(string(data))
+}
+
+// withoutSecrets returns a parameter value without the secrets it carries,
+// which are Redis host passwords. Those are set with host_passwords_wo.
+func withoutSecrets(key string, value interface{}) interface{} {
+	hosts, ok := value.(map[string]interface{})
+	if key != "hosts" || !ok {
+		return value
+	}
+
+	redacted := make(map[string]interface{}, len(hosts))
+	for hostKey, hostValue := range hosts {
+		host, ok := hostValue.(map[string]interface{})
+		if !ok {
+			redacted[hostKey] = hostValue
+			continue
+		}
+		copied := make(map[string]interface{}, len(host))
+		for field, v := range host {
+			if field != "password" {
+				copied[field] = v
+			}
+		}
+		redacted[hostKey] = copied
+	}
+	return redacted
+}
+
+// unmodeledParameters returns every parameter the API returned that has no
+// dedicated schema attribute, or null when there are none.
+func unmodeledParameters(check *client.Check) types.String {
+	extra := make(map[string]interface{})
+	for key, value := range check.Parameters.Raw {
+		if !modeledParameters[key] {
+			extra[key] = withoutSecrets(key, value)
+		}
+	}
+	if len(extra) == 0 {
+		return types.StringNull()
+	}
+
+	data, err := json.Marshal(extra)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(data))
+}
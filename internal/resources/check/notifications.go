@@ -0,0 +1,159 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/privatestate"
+)
+
+// privateKeyNotificationContacts is the private state key holding the
+// contact IDs of the notifications this resource last sent.
+const privateKeyNotificationContacts = "notification_contacts"
+
+var _ resource.ResourceWithValidateConfig = &CheckResource{}
+
+func (r *CheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CheckResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !notificationsManaged(config) && len(config.Notifications) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notifications"),
+			"Notifications Not Managed",
+			"notifications blocks cannot be used when manage_notifications is false. Attach contacts with nodeping_check_notification resources instead.",
+		)
+	}
+
+	validateWaitConfig(config, &resp.Diagnostics)
+	validateQuietConfig(config, &resp.Diagnostics)
+}
+
+// notificationsManaged reports whether the inline notifications blocks own
+// the check's notification list. Unset or unknown values count as managed,
+// which is the default.
+func notificationsManaged(model CheckResourceModel) bool {
+	if model.ManageNotifications.IsNull() || model.ManageNotifications.IsUnknown() {
+		return true
+	}
+	return model.ManageNotifications.ValueBool()
+}
+
+// validateNotificationReferences resolves every known notification
+// contact_id and schedule against the account, so a typo fails the plan
+// instead of silently paging nobody. The lookups are cached on the client
+// for the rest of the run.
+func (r *CheckResource) validateNotificationReferences(ctx context.Context, plan CheckResourceModel, diags *diag.Diagnostics) {
+	if len(plan.Notifications) == 0 {
+		return
+	}
+
+	targets, err := r.client.NotificationTargets(ctx)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Validate Notifications",
+			"Could not list contacts, contact groups and schedules, so notification references were not checked: "+err.Error(),
+		)
+		return
+	}
+
+	for i, n := range plan.Notifications {
+		if !n.ContactID.IsNull() && !n.ContactID.IsUnknown() {
+			if err := targets.ValidateContactID(n.ContactID.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("notifications").AtListIndex(i).AtName("contact_id"),
+					"Unknown Notification Contact",
+					err.Error(),
+				)
+			}
+		}
+		if !n.Schedule.IsNull() && !n.Schedule.IsUnknown() {
+			if err := targets.ValidateSchedule(n.Schedule.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("notifications").AtListIndex(i).AtName("schedule"),
+					"Unknown Notification Schedule",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// setSentNotifications records the contact IDs of the notifications in req,
+// or removes the record when the resource does not manage notifications.
+func setSentNotifications(ctx context.Context, private privatestate.Store, plan CheckResourceModel, req client.CheckCreateRequest, diags *diag.Diagnostics) {
+	if !notificationsManaged(plan) {
+		diags.Append(private.SetKey(ctx, privateKeyNotificationContacts, nil)...)
+		return
+	}
+
+	contacts := make([]string, 0, len(req.Notifications))
+	for _, n := range req.Notifications {
+		for contactID := range n {
+			contacts = append(contacts, contactID)
+		}
+	}
+	sort.Strings(contacts)
+	privatestate.SetJSON(ctx, private, privateKeyNotificationContacts, contacts, diags)
+}
+
+// warnExternalNotifications warns when a plan removes notifications that
+// this resource never sent, which were most likely attached by
+// nodeping_check_notification. With manage_notifications on, every apply
+// would remove them again. Checks without a record, such as imported ones,
+// are not checked.
+func warnExternalNotifications(ctx context.Context, private privatestate.Store, state, plan CheckResourceModel, diags *diag.Diagnostics) {
+	if !notificationsManaged(plan) {
+		return
+	}
+	var sent []string
+	if !privatestate.GetJSON(ctx, private, privateKeyNotificationContacts, &sent, diags) {
+		return
+	}
+
+	kept := make(map[string]bool, len(sent))
+	for _, contactID := range sent {
+		kept[contactID] = true
+	}
+	for _, n := range effectiveNotifications(ctx, plan, diags) {
+		kept[n.ContactID.ValueString()] = true
+	}
+
+	var external []string
+	for _, n := range effectiveNotifications(ctx, state, diags) {
+		if contactID := n.ContactID.ValueString(); !kept[contactID] {
+			external = append(external, contactID)
+		}
+	}
+	if len(external) == 0 {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("manage_notifications"),
+		"Notifications Added Outside This Resource Will Be Removed",
+		fmt.Sprintf("Check %s has notifications for %s that this resource did not add, for example with nodeping_check_notification. "+
+			"manage_notifications is true, so applying this plan removes them, and every later apply removes them again. "+
+			"Set manage_notifications = false to leave them in place.",
+			state.ID.ValueString(), strings.Join(external, ", ")),
+	)
+}
+
+// effectiveNotifications returns the notifications blocks, or the default
+// notifications when there are none.
+func effectiveNotifications(ctx context.Context, model CheckResourceModel, diags *diag.Diagnostics) []NotificationModel {
+	if len(model.Notifications) > 0 {
+		return model.Notifications
+	}
+	return defaultNotificationModels(ctx, model, diags)
+}
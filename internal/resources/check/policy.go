@@ -0,0 +1,81 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/policy"
+)
+
+// enforcePolicy evaluates the provider's policy block against the planned
+// check, once default tags are merged. Values unknown at plan time are not
+// judged.
+func (r *CheckResource) enforcePolicy(ctx context.Context, plan CheckResourceModel, diags *diag.Diagnostics) {
+	if r.policy == nil {
+		return
+	}
+
+	check := policy.Check{}
+
+	switch {
+	case !plan.Label.IsNull() && !plan.Label.IsUnknown():
+		label := plan.Label.ValueString()
+		check.Label = &label
+	case plan.Label.IsUnknown() && !plan.Target.IsNull() && !plan.Target.IsUnknown():
+		// NodePing labels an unlabelled check with its target
+		target := plan.Target.ValueString()
+		check.Label = &target
+	}
+
+	if !plan.Interval.IsNull() && !plan.Interval.IsUnknown() {
+		interval := plan.Interval.ValueFloat64()
+		check.Interval = &interval
+	}
+
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		enabled := plan.Enabled.ValueBool()
+		check.Enabled = &enabled
+	}
+
+	if !plan.Tags.IsUnknown() {
+		check.Tags, check.TagsKnown = knownStrings(plan.Tags)
+	}
+
+	if !plan.RunLocations.IsUnknown() {
+		check.RunLocations, _ = knownStrings(plan.RunLocations)
+	}
+
+	// Notifications attached by nodeping_check_notification are not visible here
+	if notificationsManaged(plan) && !plan.DefaultNotifications.IsUnknown() {
+		count := len(plan.Notifications) + len(plan.DefaultNotifications.Elements())
+		check.Notifications = &count
+	}
+
+	for _, v := range r.policy.Evaluate(check) {
+		summary := "Policy Violation: " + v.Rule
+		if v.IsError() {
+			diags.AddAttributeError(path.Root(v.Attribute), summary, v.Message)
+		} else {
+			diags.AddAttributeWarning(path.Root(v.Attribute), summary, v.Message)
+		}
+	}
+}
+
+// knownStrings returns the known elements of a string list, and whether
+// every element was known.
+func knownStrings(list types.List) ([]string, bool) {
+	var values []string
+	allKnown := true
+	for _, elem := range list.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsUnknown() || s.IsNull() {
+			allKnown = false
+			continue
+		}
+		values = append(values, s.ValueString())
+	}
+	return values, allKnown
+}
@@ -0,0 +1,48 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// checkDeletionProtection adds an error and reports true when the check in
+// state may not be destroyed, either because deletion_protection is set or
+// because it carries one of the provider's protect_tags. Both are read
+// from state, so lifting the protection takes an apply of its own.
+func (r *CheckResource) checkDeletionProtection(ctx context.Context, state CheckResourceModel, diags *diag.Diagnostics) bool {
+	id := state.ID.ValueString()
+
+	if state.DeletionProtection.ValueBool() {
+		diags.AddError(
+			"Check Is Protected From Deletion",
+			fmt.Sprintf("Check ID %s (%s) has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", id, state.Label.ValueString()),
+		)
+		return true
+	}
+
+	protectTags := r.client.GetProtectTags()
+	if len(protectTags) == 0 || state.Tags.IsNull() || state.Tags.IsUnknown() {
+		return false
+	}
+
+	var tags []string
+	diags.Append(state.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		return true
+	}
+
+	for _, tag := range tags {
+		for _, protected := range protectTags {
+			if tag == protected {
+				diags.AddError(
+					"Check Is Protected From Deletion",
+					fmt.Sprintf("Check ID %s (%s) carries the tag %q, which is listed in the provider's protect_tags. Remove the tag and apply before destroying it.", id, state.Label.ValueString(), tag),
+				)
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package check
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+// Values of quiet_status.
+const (
+	quietOff      = "off"
+	quietMuted    = "muted"
+	quietPassing  = "passing"
+	quietReleased = "released"
+)
+
+// defaultQuietTimeout bounds the mute of a new check whose target never
+// comes up, so it cannot stay silent forever.
+const defaultQuietTimeout = 24 * time.Hour
+
+// validateQuietConfig rejects a quiet_timeout that is not a duration.
+func validateQuietConfig(config CheckResourceModel, diags *diag.Diagnostics) {
+	if config.QuietTimeout.IsNull() || config.QuietTimeout.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(config.QuietTimeout.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("quiet_timeout"),
+			"Invalid Quiet Timeout",
+			`quiet_timeout must be a duration such as "30m" or "24h": `+err.Error(),
+		)
+	}
+}
+
+// quietRequested reports whether quiet_until_passing is set. Unset counts
+// as false.
+func quietRequested(model CheckResourceModel) bool {
+	return !model.QuietUntilPassing.IsNull() && !model.QuietUntilPassing.IsUnknown() && model.QuietUntilPassing.ValueBool()
+}
+
+// quietActive reports whether the check is still under its quiet mute,
+// which the resource does not own until it is lifted.
+func quietActive(status types.String) bool {
+	return status.ValueString() == quietMuted || status.ValueString() == quietPassing
+}
+
+// planQuietStatus decides the quiet_status of a plan from the prior state.
+// A new check starts muted. A check that a refresh saw passing is planned
+// as released, so the apply lifts the mute. Turning the option on for an
+// existing check does not mute it.
+func planQuietStatus(plan, state *CheckResourceModel) types.String {
+	if !quietRequested(*plan) {
+		return types.StringValue(quietOff)
+	}
+	if state == nil {
+		if !plan.Mute.IsNull() && plan.Mute.ValueBool() {
+			return types.StringValue(quietOff)
+		}
+		return types.StringValue(quietMuted)
+	}
+	switch state.QuietStatus.ValueString() {
+	case quietMuted:
+		return types.StringValue(quietMuted)
+	default:
+		return types.StringValue(quietReleased)
+	}
+}
+
+// applyQuietMute sets the request's mute for the planned quiet status. A
+// new quiet check is muted until the quiet timeout; a check still waiting
+// for its first pass keeps whatever mute the API holds.
+func applyQuietMute(plan *CheckResourceModel, req *client.CheckCreateRequest, creating bool) {
+	if !quietActive(plan.QuietStatus) {
+		return
+	}
+	if !creating {
+		req.Mute = nil
+		return
+	}
+
+	timeout := defaultQuietTimeout
+	if !plan.QuietTimeout.IsNull() {
+		if d, err := time.ParseDuration(plan.QuietTimeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+	req.Mute = time.Now().Add(timeout).UnixMilli()
+}
+
+// releaseQuietMute lifts the quiet mute right after the check was seen
+// passing, by resending the create request with the configured mute. If
+// that fails, the next apply lifts it instead.
+func (r *CheckResource) releaseQuietMute(ctx context.Context, model *CheckResourceModel, req client.CheckCreateRequest, diags *diag.Diagnostics) {
+	req.Mute = !model.Mute.IsNull() && model.Mute.ValueBool()
+
+	tflog.Debug(ctx, "Lifting quiet mute", map[string]interface{}{
+		"id": model.ID.ValueString(),
+	})
+
+	if _, err := r.client.UpdateCheck(ctx, model.ID.ValueString(), client.CheckUpdateRequest{CheckCreateRequest: req}); err != nil {
+		diags.AddWarning(
+			"Quiet Mute Not Lifted",
+			"Check ID "+model.ID.ValueString()+" is passing, but its quiet mute could not be lifted. The next apply lifts it: "+err.Error(),
+		)
+		model.QuietStatus = types.StringValue(quietPassing)
+		return
+	}
+	model.QuietStatus = types.StringValue(quietReleased)
+}
+
+// refreshQuietStatus advances quiet_status from what the API reports. The
+// mute timestamp is NodePing's own; once it lapses or is removed, the
+// check is released without an apply. A pass is taken from the results
+// rather than the check's state, which a new check reports before it runs.
+func (r *CheckResource) refreshQuietStatus(ctx context.Context, check *client.Check, model *CheckResourceModel) {
+	if !quietActive(model.QuietStatus) {
+		return
+	}
+
+	until, timed := muteTimestamp(check.Mute)
+	if !timed || until <= time.Now().UnixMilli() {
+		model.QuietStatus = types.StringValue(quietReleased)
+		return
+	}
+	if model.QuietStatus.ValueString() == quietPassing {
+		return
+	}
+
+	result, err := r.latestResultSince(ctx, check.ID, check.Created)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read check results for quiet_until_passing", map[string]interface{}{
+			"id":    check.ID,
+			"error": err.Error(),
+		})
+		return
+	}
+	if result != nil && result.Success {
+		model.QuietStatus = types.StringValue(quietPassing)
+	}
+}
+
+// muteTimestamp returns the time, in milliseconds, a timed mute ends.
+func muteTimestamp(v interface{}) (int64, bool) {
+	switch val := v.(type) {
+	case float64:
+		if val > 1 {
+			return int64(val), true
+		}
+	case int64:
+		if val > 1 {
+			return val, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,56 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// checkAccountLimits compares the planned check with the limits of the
+// account's plan. An interval below the plan's minimum is an error, since
+// the API would reject it. Creates beyond the check quota only warn: the
+// count is taken once per run and may be stale, and a check planned more
+// than once is counted more than once, so the planned total can be too
+// high.
+func (r *CheckResource) checkAccountLimits(ctx context.Context, plan CheckResourceModel, creating bool, diags *diag.Diagnostics) {
+	info, err := r.client.CachedAccountInfo(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read account info, skipping plan limit checks", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if minInterval := info.Limits.MinInterval; minInterval > 0 && !plan.Interval.IsNull() && !plan.Interval.IsUnknown() {
+		if interval := plan.Interval.ValueFloat64(); interval < minInterval {
+			diags.AddAttributeError(
+				path.Root("interval"),
+				"Interval Below Plan Minimum",
+				fmt.Sprintf("The account's %s plan allows intervals of %g minutes or more, got %g.", planName(info.Plan), minInterval, interval),
+			)
+		}
+	}
+
+	if !creating || info.Limits.Checks <= 0 {
+		return
+	}
+
+	planned := r.client.PlanCheckCreate()
+	if info.Counts.Checks+planned > info.Limits.Checks {
+		diags.AddWarning(
+			"Check Quota Exceeded",
+			fmt.Sprintf("The account's %s plan allows %d checks. %d exist and up to %d are planned for creation in this run, so some creates may fail partway through the apply. A check planned more than once in a run is counted each time, so this warning can appear when the quota is not exceeded.",
+				planName(info.Plan), info.Limits.Checks, info.Counts.Checks, planned),
+		)
+	}
+}
+
+func planName(plan string) string {
+	if plan == "" {
+		return "current"
+	}
+	return plan
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/policy"
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
 )
 
 var (
@@ -21,7 +25,9 @@ var (
 )
 
 type CheckResource struct {
-	client *client.Client
+	client   *client.Client
+	policy   *policy.Policy
+	defaults *providerdata.CheckDefaults
 }
 
 func NewCheckResource() resource.Resource {
@@ -33,7 +39,7 @@ func (r *CheckResource) Metadata(ctx context.Context, req resource.MetadataReque
 }
 
 func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = CheckSchema()
+	resp.Schema = CheckSchema(ctx)
 }
 
 func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,16 +47,18 @@ func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.policy = data.Policy
+	r.defaults = data.CheckDefaults
 }
 
 func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -60,15 +68,29 @@ func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating check", map[string]interface{}{
 		"type":   plan.Type.ValueString(),
 		"target": plan.Target.ValueString(),
 	})
 
+	var configuredExtra types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("extra_parameters_json"), &configuredExtra)...)
+
 	createReq := r.buildCreateRequest(ctx, &plan, &resp.Diagnostics)
+	applyExtraParameters(&plan, &createReq, &resp.Diagnostics)
+	hashes := applyWriteOnlyConfig(ctx, req.Config, &createReq, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	applyQuietMute(&plan, &createReq, true)
 
 	check, err := r.client.CreateCheck(ctx, createReq)
 	if err != nil {
@@ -82,9 +104,22 @@ func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Preserve the original target from plan if API normalized it (e.g., added trailing slash)
 	originalTarget := plan.Target
 	plannedTags := plan.Tags
+	plannedSendHeaders := plan.SendHeaders
+	plannedMute := plan.Mute
+	plannedDefaultNotifications := plan.DefaultNotifications
 
 	r.mapCheckToModel(ctx, check, &plan)
 
+	// The quiet mute is a timestamp; state keeps the configured mute
+	if quietActive(plan.QuietStatus) {
+		plan.Mute = plannedMute
+	}
+	plan.DefaultNotifications = plannedDefaultNotifications
+	resolveUnknownDefaults(&plan)
+	if plan.ExtraParametersJSON.IsUnknown() {
+		plan.ExtraParametersJSON = unmodeledParameters(check)
+	}
+
 	// Restore original target if it's semantically equivalent (trailing slash difference)
 	if normalizeURL(originalTarget.ValueString()) == normalizeURL(plan.Target.ValueString()) {
 		plan.Target = originalTarget
@@ -97,11 +132,27 @@ func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// Secret headers from sendheaders_wo must never reach state
+	plan.SendHeaders = plannedSendHeaders
+
+	setWriteOnlyHashes(ctx, resp.Private, hashes, &resp.Diagnostics)
+	setConfiguredExtraKeys(ctx, resp.Private, configuredExtra, &resp.Diagnostics)
+	setSentNotifications(ctx, resp.Private, plan, createReq, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Created check", map[string]interface{}{
 		"id": check.ID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	passed := r.waitForResult(ctx, plan, check.Created, &resp.Diagnostics)
+	if passed && quietActive(plan.QuietStatus) {
+		r.releaseQuietMute(ctx, &plan, createReq, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
 }
 
 func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -111,6 +162,14 @@ func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Reading check", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
@@ -133,9 +192,34 @@ func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Preserve the original target from state if API normalized it
 	originalTarget := state.Target
+	if state.ManageNotifications.IsNull() {
+		state.ManageNotifications = types.BoolValue(true)
+	}
+	if state.QuietStatus.IsNull() {
+		state.QuietStatus = types.StringValue(quietOff)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyDelete)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.DefaultsApplied.IsNull() {
+		state.DefaultsApplied = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	priorMute := state.Mute
 
 	r.mapCheckToModel(ctx, check, &state)
 
+	r.refreshQuietStatus(ctx, check, &state)
+	if quietActive(state.QuietStatus) {
+		state.Mute = priorMute
+	}
+
+	hashes := getWriteOnlyHashes(ctx, req.Private, &resp.Diagnostics)
+	applyWriteOnlyState(ctx, check, hashes, &state)
+	state.ExtraParametersJSON = readExtraParameters(check, state.ExtraParametersJSON)
+
 	// Restore original target if it's semantically equivalent (trailing slash difference)
 	if normalizeURL(originalTarget.ValueString()) == normalizeURL(state.Target.ValueString()) {
 		state.Target = originalTarget
@@ -154,15 +238,35 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Updating check", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
 
+	// Serialize with nodeping_check_notification resources editing the same check
+	unlock := r.client.Lock("check", state.ID.ValueString())
+	defer unlock()
+
+	var configuredExtra types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("extra_parameters_json"), &configuredExtra)...)
+
 	createReq := r.buildCreateRequest(ctx, &plan, &resp.Diagnostics)
+	applyExtraParameters(&plan, &createReq, &resp.Diagnostics)
+	clearRemovedExtraParameters(ctx, req.Private, &plan, &createReq, &resp.Diagnostics)
+	hashes := applyWriteOnlyConfig(ctx, req.Config, &createReq, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	applyQuietMute(&plan, &createReq, false)
+
 	updateReq := client.CheckUpdateRequest{CheckCreateRequest: createReq}
 
 	check, err := r.client.UpdateCheck(ctx, state.ID.ValueString(), updateReq)
@@ -181,6 +285,9 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plannedModified := plan.Modified
 	plannedContentString := plan.ContentString
 	plannedTags := plan.Tags
+	plannedSendHeaders := plan.SendHeaders
+	plannedMute := plan.Mute
+	plannedDefaultNotifications := plan.DefaultNotifications
 
 	r.mapCheckToModel(ctx, check, &plan)
 
@@ -189,6 +296,19 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.Target = originalTarget
 	}
 
+	// The quiet mute is a timestamp; state keeps the configured mute
+	if quietActive(plan.QuietStatus) {
+		plan.Mute = plannedMute
+	}
+	plan.DefaultNotifications = plannedDefaultNotifications
+	resolveUnknownDefaults(&plan)
+	if plan.ExtraParametersJSON.IsUnknown() {
+		plan.ExtraParametersJSON = unmodeledParameters(check)
+	}
+
+	// Secret headers from sendheaders_wo must never reach state
+	plan.SendHeaders = plannedSendHeaders
+
 	// Restore planned modified value - API always returns new timestamp but Terraform
 	// expects the value from the plan (UseStateForUnknown preserves it)
 	if !plannedModified.IsUnknown() {
@@ -212,11 +332,22 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	setWriteOnlyHashes(ctx, resp.Private, hashes, &resp.Diagnostics)
+	setConfiguredExtraKeys(ctx, resp.Private, configuredExtra, &resp.Diagnostics)
+	setSentNotifications(ctx, resp.Private, plan, createReq, &resp.Diagnostics)
+
 	tflog.Debug(ctx, "Updated check", map[string]interface{}{
 		"id": check.ID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if waitOnUpdate(state, plan) {
+		r.waitForResult(ctx, plan, check.Modified, &resp.Diagnostics)
+	}
 }
 
 func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -226,6 +357,37 @@ func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if r.checkDeletionProtection(ctx, state, &resp.Diagnostics) {
+		return
+	}
+
+	if mode := onDestroyMode(state); mode != onDestroyDelete {
+		tflog.Debug(ctx, "Disabling check instead of deleting it", map[string]interface{}{
+			"id":         state.ID.ValueString(),
+			"on_destroy": mode,
+		})
+
+		err := r.decommissionCheck(ctx, state.ID.ValueString(), mode == onDestroyDisableAndTag)
+		if err != nil {
+			if _, ok := err.(*client.NotFoundError); ok {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Disabling Check",
+				"Could not disable check ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+		}
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting check", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
@@ -287,18 +449,35 @@ func (r *CheckResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	var state CheckResourceModel
 	r.mapCheckToModel(ctx, check, &state)
+	state.ManageNotifications = types.BoolValue(true)
+	state.QuietStatus = types.StringValue(quietOff)
+	state.OnDestroy = types.StringValue(onDestroyDelete)
+	state.DeletionProtection = types.BoolValue(false)
+	state.DefaultNotifications = types.ListNull(types.ObjectType{AttrTypes: notificationAttrTypes})
+	state.DefaultsApplied = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	state.Timeouts = nullTimeouts()
+	state.ExtraParametersJSON = readExtraParameters(check, types.StringNull())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *CheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Skip if destroying or client not configured
-	if req.Plan.Raw.IsNull() || r.client == nil {
+	// Skip if client not configured
+	if r.client == nil {
 		return
 	}
 
-	defaultTags := r.client.GetDefaultTags()
-	if len(defaultTags) == 0 {
+	// Fail the destroy plan itself, rather than the apply, for protected checks
+	if req.Plan.Raw.IsNull() {
+		if req.State.Raw.IsNull() {
+			return
+		}
+		var state CheckResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.checkDeletionProtection(ctx, state, &resp.Diagnostics)
 		return
 	}
 
@@ -308,11 +487,57 @@ func (r *CheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	r.validateNotificationReferences(ctx, plan, &resp.Diagnostics)
+
+	var prior *CheckResourceModel
+	if !req.State.Raw.IsNull() {
+		var state CheckResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = &state
+	}
+	plan.QuietStatus = planQuietStatus(&plan, prior)
+
+	var config CheckResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.applyCheckDefaults(ctx, config, &plan, &resp.Diagnostics)
+	if prior != nil {
+		planExtraParametersRemoval(ctx, req.Private, config, &plan, &resp.Diagnostics)
+		warnExternalNotifications(ctx, req.Private, *prior, plan, &resp.Diagnostics)
+	}
+
+	r.mergeDefaultTags(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.enforcePolicy(ctx, plan, &resp.Diagnostics)
+	r.checkAccountLimits(ctx, plan, prior == nil, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// mergeDefaultTags adds the provider's default_tags and managed_tag to the
+// planned tags.
+func (r *CheckResource) mergeDefaultTags(ctx context.Context, plan *CheckResourceModel, diags *diag.Diagnostics) {
+	defaultTags := r.client.GetDefaultTags()
+	if managedTag := r.client.GetManagedTag(); managedTag != "" {
+		defaultTags = append(append([]string{}, defaultTags...), managedTag)
+	}
+	if len(defaultTags) == 0 {
+		return
+	}
+
 	// Get configured tags from plan
 	var configuredTags []string
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &configuredTags, false)...)
-		if resp.Diagnostics.HasError() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &configuredTags, false)...)
+		if diags.HasError() {
 			return
 		}
 	}
@@ -337,14 +562,13 @@ func (r *CheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	for i, tag := range uniqueTags {
 		tagElements[i] = types.StringValue(tag)
 	}
-	tagsList, diags := types.ListValueFrom(ctx, types.StringType, tagElements)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	tagsList, d := types.ListValueFrom(ctx, types.StringType, tagElements)
+	diags.Append(d...)
+	if diags.HasError() {
 		return
 	}
 
 	plan.Tags = tagsList
-	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *CheckResource) buildCreateRequest(ctx context.Context, plan *CheckResourceModel, diags *diag.Diagnostics) client.CheckCreateRequest {
@@ -410,7 +634,7 @@ func (r *CheckResource) buildCreateRequest(ctx context.Context, plan *CheckResou
 	}
 
 	// Tags are already merged with default_tags in ModifyPlan
-	if !plan.Tags.IsNull() {
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		var tags []string
 		diags.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
 		req.Tags = tags
@@ -540,8 +764,12 @@ func (r *CheckResource) buildCreateRequest(ctx context.Context, plan *CheckResou
 		req.SNMPCom = plan.SNMPCom.ValueString()
 	}
 
-	if len(plan.Notifications) > 0 {
-		for _, n := range plan.Notifications {
+	notifications := plan.Notifications
+	if len(notifications) == 0 {
+		notifications = defaultNotificationModels(ctx, *plan, diags)
+	}
+	if notificationsManaged(*plan) && len(notifications) > 0 {
+		for _, n := range notifications {
 			notif := map[string]interface{}{
 				n.ContactID.ValueString(): map[string]interface{}{
 					"delay":    int(n.Delay.ValueInt64()),
@@ -555,6 +783,38 @@ func (r *CheckResource) buildCreateRequest(ctx context.Context, plan *CheckResou
 	return req
 }
 
+// MapCheck converts an API check into the resource model, as reading a
+// freshly imported check would. The nodeping_check data source uses it so
+// both expose the same parameters. It also maps the parameters the
+// resource keeps from its configuration rather than reading back, and
+// every parameter without a dedicated attribute into extra_parameters_json.
+func MapCheck(ctx context.Context, check *client.Check) CheckResourceModel {
+	var model CheckResourceModel
+	(&CheckResource{}).mapCheckToModel(ctx, check, &model)
+
+	model.PostData = stringOrNull(check.Parameters.PostData)
+	model.Secure = stringOrNull(check.Parameters.Secure)
+	model.DNSSection = stringOrNull(check.Parameters.DNSSection)
+	model.Transport = stringOrNull(check.Parameters.Transport)
+	model.Email = stringOrNull(check.Parameters.Email)
+	model.Database = stringOrNull(check.Parameters.Database)
+	model.Query = stringOrNull(check.Parameters.Query)
+	model.Namespace = stringOrNull(check.Parameters.Namespace)
+	model.SNMPv = stringOrNull(check.Parameters.SNMPv)
+	model.HomeLoc = stringOrNull(check.HomeLoc)
+	model.ExtraParametersJSON = unmodeledParameters(check)
+	return model
+}
+
+// stringOrNull returns a non-empty string value, or null. The API returns
+// false for some unset string parameters, which is null too.
+func stringOrNull(v interface{}) types.String {
+	if s, ok := v.(string); ok && s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
 func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check, model *CheckResourceModel) {
 	model.ID = types.StringValue(check.ID)
 	model.CustomerID = types.StringValue(check.CustomerID)
@@ -721,7 +981,11 @@ func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check
 		model.Username = types.StringNull()
 	}
 
-	model.Password = types.StringNull()
+	// The API echoes the password, but it only belongs in state when the
+	// legacy password attribute is in use. password_wo is tracked by hash.
+	if !model.Password.IsNull() && check.Parameters.Password != "" {
+		model.Password = types.StringValue(check.Parameters.Password)
+	}
 
 	if len(check.Parameters.SendHeaders) > 0 {
 		headers, _ := types.MapValueFrom(ctx, types.StringType, check.Parameters.SendHeaders)
@@ -750,40 +1014,60 @@ func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check
 		model.ClientCert = types.StringNull()
 	}
 
-	if len(check.Notifications) > 0 {
-		model.Notifications = make([]NotificationModel, 0, len(check.Notifications))
-		// Track seen notifications to avoid duplicates
-		seen := make(map[string]bool)
-		for _, n := range check.Notifications {
-			for contactID, config := range n {
-				if configMap, ok := config.(map[string]interface{}); ok {
-					var delay int64
-					if d, ok := configMap["delay"].(float64); ok {
-						delay = int64(d)
-					}
-					schedule := "All"
-					if s, ok := configMap["schedule"].(string); ok {
-						schedule = s
-					}
-					// Create unique key for deduplication
-					key := fmt.Sprintf("%s:%d:%s", contactID, delay, schedule)
-					if seen[key] {
-						continue // Skip duplicate
-					}
-					seen[key] = true
-
-					notif := NotificationModel{
-						ContactID: types.StringValue(contactID),
-						Delay:     types.Int64Value(delay),
-						Schedule:  types.StringValue(schedule),
-					}
-					model.Notifications = append(model.Notifications, notif)
+	switch {
+	case !notificationsManaged(*model):
+		// Owned by nodeping_check_notification resources
+		model.Notifications = nil
+	case usesDefaultNotifications(*model):
+		// Sent from check_defaults; drift shows up on default_notifications
+		model.Notifications = nil
+		list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: notificationAttrTypes}, apiNotifications(check))
+		if !diags.HasError() {
+			model.DefaultNotifications = list
+		}
+	default:
+		model.Notifications = apiNotifications(check)
+	}
+}
+
+// apiNotifications converts the check's notifications, dropping
+// duplicates. It returns nil when there are none.
+func apiNotifications(check *client.Check) []NotificationModel {
+	if len(check.Notifications) == 0 {
+		return nil
+	}
+
+	notifications := make([]NotificationModel, 0, len(check.Notifications))
+	// Track seen notifications to avoid duplicates
+	seen := make(map[string]bool)
+	for _, n := range check.Notifications {
+		for contactID, config := range n {
+			if configMap, ok := config.(map[string]interface{}); ok {
+				var delay int64
+				if d, ok := configMap["delay"].(float64); ok {
+					delay = int64(d)
+				}
+				schedule := "All"
+				if s, ok := configMap["schedule"].(string); ok {
+					schedule = s
 				}
+				// Create unique key for deduplication
+				key := fmt.Sprintf("%s:%d:%s", contactID, delay, schedule)
+				if seen[key] {
+					continue // Skip duplicate
+				}
+				seen[key] = true
+
+				notif := NotificationModel{
+					ContactID: types.StringValue(contactID),
+					Delay:     types.Int64Value(delay),
+					Schedule:  types.StringValue(schedule),
+				}
+				notifications = append(notifications, notif)
 			}
 		}
-	} else {
-		model.Notifications = nil
 	}
+	return notifications
 }
 
 func normalizeURL(u string) string {
@@ -1,17 +1,24 @@
 package check
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/planmodifiers"
 )
 
 var ValidCheckTypes = []string{
@@ -73,6 +80,32 @@ type CheckResourceModel struct {
 	ClientCert     types.String        `tfsdk:"clientcert"`
 	SNMPv          types.String        `tfsdk:"snmpv"`
 	SNMPCom        types.String        `tfsdk:"snmpcom"`
+
+	ExtraParametersJSON types.String   `tfsdk:"extra_parameters_json"`
+	ManageNotifications types.Bool     `tfsdk:"manage_notifications"`
+	WaitForStatus       types.String   `tfsdk:"wait_for_status"`
+	WaitTimeout         types.String   `tfsdk:"wait_timeout"`
+	QuietUntilPassing   types.Bool     `tfsdk:"quiet_until_passing"`
+	QuietTimeout        types.String   `tfsdk:"quiet_timeout"`
+	QuietStatus         types.String   `tfsdk:"quiet_status"`
+	OnDestroy           types.String   `tfsdk:"on_destroy"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+
+	// Filled from the provider's check_defaults in ModifyPlan
+	DefaultNotifications types.List `tfsdk:"default_notifications"`
+	DefaultsApplied      types.Map  `tfsdk:"defaults_applied"`
+
+	// Write-only credentials. These are never persisted to plan or state;
+	// the matching *_version attributes trigger an update when rotated.
+	PasswordWO             types.String `tfsdk:"password_wo"`
+	PasswordWOVersion      types.Int64  `tfsdk:"password_wo_version"`
+	SNMPComWO              types.String `tfsdk:"snmpcom_wo"`
+	SNMPComWOVersion       types.Int64  `tfsdk:"snmpcom_wo_version"`
+	SendHeadersWO          types.Map    `tfsdk:"sendheaders_wo"`
+	SendHeadersWOVersion   types.Int64  `tfsdk:"sendheaders_wo_version"`
+	HostPasswordsWO        types.Map    `tfsdk:"host_passwords_wo"`
+	HostPasswordsWOVersion types.Int64  `tfsdk:"host_passwords_wo_version"`
 }
 
 type NotificationModel struct {
@@ -81,7 +114,7 @@ type NotificationModel struct {
 	Schedule  types.String `tfsdk:"schedule"`
 }
 
-func CheckSchema() schema.Schema {
+func CheckSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Manages a NodePing check.",
 		MarkdownDescription: `
@@ -221,13 +254,15 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				Optional:    true,
 			},
 			"runlocations": schema.ListAttribute{
-				Description: "Probe locations to run the check from.",
+				Description: "Probe locations to run the check from. Defaults to the provider's check_defaults, if set.",
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 			},
 			"homeloc": schema.StringAttribute{
-				Description: "Preferred probe location for the check.",
+				Description: "Preferred probe location for the check. Defaults to the provider's check_defaults, if set.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"autodiag": schema.BoolAttribute{
 				Description: "Enable automated diagnostics.",
@@ -242,6 +277,85 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"manage_notifications": schema.BoolAttribute{
+				Description:         "Whether this resource manages the check's notifications. When true, notifications added outside this resource are removed on the next apply, with a plan warning. Set to false when notifications are attached with nodeping_check_notification resources; notifications blocks are then not allowed and existing notifications are left untouched. Defaults to true.",
+				MarkdownDescription: "Whether this resource manages the check's notifications. When true, notifications added outside this resource are removed on the next apply, with a plan warning. Set to `false` when notifications are attached with `nodeping_check_notification` resources; `notifications` blocks are then not allowed and existing notifications are left untouched. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"wait_for_status": schema.StringAttribute{
+				Description:         "Wait after create, and after an update that enables the check or changes its target or type, until the check reports its first result. 'passing' fails the apply with the check's error message if the result is a failure; 'any' only waits. Not set by default.",
+				MarkdownDescription: "Wait after create, and after an update that enables the check or changes its target or type, until the check reports its first result. `passing` fails the apply with the check's error message if the result is a failure; `any` only waits. Not set by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(waitForPassing, waitForAny),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				Description:         "How long to wait for the first result when wait_for_status is set, as a duration such as '90s' or '5m'. Defaults to '3m'. The create and update timeouts still apply.",
+				MarkdownDescription: "How long to wait for the first result when `wait_for_status` is set, as a duration such as `90s` or `5m`. Defaults to `3m`. The `create` and `update` timeouts still apply.",
+				Optional:            true,
+			},
+			"quiet_until_passing": schema.BoolAttribute{
+				Description:         "Create the check muted until it first passes, so targets that are not live yet do not page anyone. The mute is lifted by the next apply after a refresh sees the check passing, or during the same apply when wait_for_status sees a pass. Only applies when the check is created. Defaults to false.",
+				MarkdownDescription: "Create the check muted until it first passes, so targets that are not live yet do not page anyone. The mute is lifted by the next apply after a refresh sees the check passing, or during the same apply when `wait_for_status` sees a pass. Only applies when the check is created. Defaults to `false`.",
+				Optional:            true,
+			},
+			"quiet_timeout": schema.StringAttribute{
+				Description:         "Longest time the quiet_until_passing mute lasts, as a duration such as '30m' or '24h'. NodePing lifts the mute on its own once it ends. Defaults to '24h'.",
+				MarkdownDescription: "Longest time the `quiet_until_passing` mute lasts, as a duration such as `30m` or `24h`. NodePing lifts the mute on its own once it ends. Defaults to `24h`.",
+				Optional:            true,
+			},
+			"quiet_status": schema.StringAttribute{
+				Description:         "Where the check stands with quiet_until_passing: 'off', 'muted' (waiting for the first pass), 'passing' (seen passing; the next apply lifts the mute) or 'released'.",
+				MarkdownDescription: "Where the check stands with `quiet_until_passing`: `off`, `muted` (waiting for the first pass), `passing` (seen passing; the next apply lifts the mute) or `released`.",
+				Computed:            true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Description:         "What happens to the check in NodePing when this resource is destroyed. 'delete' removes it; 'disable' keeps it, with its history, but disabled; 'disable_and_tag' also adds a 'decommissioned' tag and a 'decommissioned:<timestamp>' tag. Must be applied before the destroy to take effect. Defaults to 'delete'.",
+				MarkdownDescription: "What happens to the check in NodePing when this resource is destroyed. `delete` removes it; `disable` keeps it, with its history, but disabled; `disable_and_tag` also adds a `decommissioned` tag and a `decommissioned:<timestamp>` tag. Must be applied before the destroy to take effect. Defaults to `delete`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(onDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyDelete, onDestroyDisable, onDestroyDisableAndTag),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description:         "Prevent the check from being destroyed. While true, destroying or replacing the check fails; set it to false and apply first. Defaults to false.",
+				MarkdownDescription: "Prevent the check from being destroyed. While `true`, destroying or replacing the check fails; set it to `false` and apply first. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_notifications": schema.ListNestedAttribute{
+				Description:         "Notifications taken from the provider's check_defaults, because the check has no notifications blocks.",
+				MarkdownDescription: "Notifications taken from the provider's `check_defaults`, because the check has no `notifications` blocks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_id": schema.StringAttribute{
+							Description: "Contact address ID or contact group ID notified.",
+							Computed:    true,
+						},
+						"delay": schema.Int64Attribute{
+							Description: "Delay in minutes before sending the notification.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Notification schedule name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"defaults_applied": schema.MapAttribute{
+				Description:         "Attributes whose values came from the provider's check_defaults, mapped to their source: 'check_defaults' or 'check_defaults.type_override.<TYPE>'.",
+				MarkdownDescription: "Attributes whose values came from the provider's `check_defaults`, mapped to their source: `check_defaults` or `check_defaults.type_override.<TYPE>`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"state": schema.Int64Attribute{
 				Description: "Current state of the check (0 = failing, 1 = passing).",
 				Computed:    true,
@@ -291,9 +405,11 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				Optional:    true,
 			},
 			"sendheaders": schema.MapAttribute{
-				Description: "HTTP headers to send with the request.",
-				Optional:    true,
-				ElementType: types.StringType,
+				Description:         "HTTP headers to send with the request. Use sendheaders_wo for headers carrying credentials.",
+				MarkdownDescription: "HTTP headers to send with the request. Use `sendheaders_wo` for headers carrying credentials.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
 			},
 			"receiveheaders": schema.MapAttribute{
 				Description: "Expected HTTP headers in the response.",
@@ -313,9 +429,28 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for authentication.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:         "Password for authentication. Stored in state; prefer password_wo.",
+				MarkdownDescription: "Password for authentication. Stored in state; prefer `password_wo`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Description:         "Write-only password for authentication. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only password for authentication. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description:         "Version of password_wo. Change this value to send a rotated password.",
+				MarkdownDescription: "Version of `password_wo`. Change this value to send a rotated password.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"secure": schema.StringAttribute{
 				Description: "SSL/TLS mode: 'false', 'ssl', or 'starttls'.",
@@ -362,8 +497,9 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				},
 			},
 			"warningdays": schema.Int64Attribute{
-				Description: "Days before certificate/domain expiry to fail.",
+				Description: "Days before certificate/domain expiry to fail. Defaults to the provider's check_defaults, if set.",
 				Optional:    true,
+				Computed:    true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
 				},
@@ -404,11 +540,80 @@ terraform import nodeping_check.example 201205050153W2Q4C-0J2HSIRF
 				},
 			},
 			"snmpcom": schema.StringAttribute{
-				Description: "SNMP community string.",
-				Optional:    true,
+				Description:         "SNMP community string. Stored in state; prefer snmpcom_wo.",
+				MarkdownDescription: "SNMP community string. Stored in state; prefer `snmpcom_wo`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("snmpcom_wo")),
+				},
+			},
+			"snmpcom_wo": schema.StringAttribute{
+				Description:         "Write-only SNMP community string. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only SNMP community string. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"snmpcom_wo_version": schema.Int64Attribute{
+				Description:         "Version of snmpcom_wo. Change this value to send a rotated community string.",
+				MarkdownDescription: "Version of `snmpcom_wo`. Change this value to send a rotated community string.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("snmpcom_wo")),
+				},
+			},
+			"sendheaders_wo": schema.MapAttribute{
+				Description:         "Write-only HTTP headers merged into sendheaders, e.g. authorization tokens. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only HTTP headers merged into `sendheaders`, e.g. authorization tokens. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+			},
+			"sendheaders_wo_version": schema.Int64Attribute{
+				Description:         "Version of sendheaders_wo. Change this value to send rotated headers.",
+				MarkdownDescription: "Version of `sendheaders_wo`. Change this value to send rotated headers.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sendheaders_wo")),
+				},
+			},
+			"host_passwords_wo": schema.MapAttribute{
+				Description:         "Write-only Redis host passwords keyed by the key of the host in the hosts parameter of extra_parameters_json. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				MarkdownDescription: "Write-only Redis host passwords keyed by the key of the host in the `hosts` parameter of `extra_parameters_json`. Never stored in plan or state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+			},
+			"host_passwords_wo_version": schema.Int64Attribute{
+				Description:         "Version of host_passwords_wo. Change this value to send rotated passwords.",
+				MarkdownDescription: "Version of `host_passwords_wo`. Change this value to send rotated passwords.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("host_passwords_wo")),
+				},
+			},
+			"extra_parameters_json": schema.StringAttribute{
+				Description:         "JSON object of additional check parameters, deep-merged into the API request after all other attributes. Use it for parameters that have no dedicated attribute. When not set, it holds every parameter without a dedicated attribute.",
+				MarkdownDescription: "JSON object of additional check parameters, deep-merged into the API request after all other attributes. Use it for parameters that have no dedicated attribute, e.g. `jsonencode({ edns = { nsid = \"\" } })`.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					planmodifiers.JSONSemanticEqual(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 			"notifications": schema.ListNestedBlock{
 				Description: "Notification configuration for the check.",
 				NestedObject: schema.NestedBlockObject{
@@ -0,0 +1,146 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+)
+
+const (
+	waitForPassing = "passing"
+	waitForAny     = "any"
+
+	// defaultWaitTimeout leaves room for the wait inside the default
+	// create and update budgets.
+	defaultWaitTimeout = 3 * time.Minute
+	waitPollInterval   = 10 * time.Second
+)
+
+// validateWaitConfig rejects a wait_timeout that is not a duration.
+func validateWaitConfig(config CheckResourceModel, diags *diag.Diagnostics) {
+	if config.WaitTimeout.IsNull() || config.WaitTimeout.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(config.WaitTimeout.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("wait_timeout"),
+			"Invalid Wait Timeout",
+			`wait_timeout must be a duration such as "90s" or "5m": `+err.Error(),
+		)
+	}
+}
+
+// waitOnUpdate reports whether an update waits for a new result. Only
+// changes that affect what the check runs are awaited: enabling it, or
+// changing its target or type. Editing a label or tags never waits, so a
+// check with a long interval does not hold up the apply.
+func waitOnUpdate(state, plan CheckResourceModel) bool {
+	if !state.Enabled.IsNull() && !state.Enabled.ValueBool() && plan.Enabled.ValueBool() {
+		return true
+	}
+	return normalizeURL(state.Target.ValueString()) != normalizeURL(plan.Target.ValueString()) ||
+		state.Type.ValueString() != plan.Type.ValueString()
+}
+
+// waitForResult polls the check's results until one newer than since (in
+// milliseconds) arrives. With wait_for_status = "passing" a failing result
+// fails the apply with the check's own message. The check is already saved
+// in state when this runs, so a failure taints it rather than orphaning it.
+// It reports whether a passing result was seen.
+func (r *CheckResource) waitForResult(ctx context.Context, model CheckResourceModel, since int64, diags *diag.Diagnostics) bool {
+	if model.WaitForStatus.IsNull() || model.WaitForStatus.IsUnknown() {
+		return false
+	}
+	status := model.WaitForStatus.ValueString()
+	id := model.ID.ValueString()
+
+	if !model.Enabled.IsNull() && !model.Enabled.ValueBool() {
+		diags.AddWarning(
+			"Check Result Not Awaited",
+			"Check "+id+" is disabled and will not produce results, so wait_for_status was ignored.",
+		)
+		return false
+	}
+
+	timeout := defaultWaitTimeout
+	if !model.WaitTimeout.IsNull() {
+		if d, err := time.ParseDuration(model.WaitTimeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if since == 0 {
+		since = time.Now().UnixMilli()
+	}
+
+	tflog.Debug(ctx, "Waiting for check result", map[string]interface{}{
+		"id":     id,
+		"status": status,
+		"since":  since,
+	})
+
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := r.latestResultSince(ctx, id, since)
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			diags.AddError(
+				"Error Waiting for Check Result",
+				"Could not read results of check ID "+id+": "+err.Error(),
+			)
+			return false
+		}
+		if result != nil {
+			if status == waitForPassing && !result.Success {
+				diags.AddError(
+					"Check Is Failing",
+					fmt.Sprintf("The first result of check ID %s after apply failed: %s", id, resultMessage(result)),
+				)
+			}
+			return result.Success
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Timed Out Waiting for Check Result",
+				fmt.Sprintf("Check ID %s produced no result within %s. Increase wait_timeout, or the create/update timeout if it is shorter.", id, timeout),
+			)
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// latestResultSince returns the newest result that started at or after
+// since, or nil when the check has not run yet.
+func (r *CheckResource) latestResultSince(ctx context.Context, id string, since int64) (*client.CheckResult, error) {
+	results, err := r.client.GetCheckResults(ctx, id, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 || results[0].Start < since {
+		return nil, nil
+	}
+	return &results[0], nil
+}
+
+func resultMessage(result *client.CheckResult) string {
+	if result.Message != "" {
+		return result.Message
+	}
+	if result.StatusCode != nil {
+		return fmt.Sprintf("status %v", result.StatusCode)
+	}
+	return "no message"
+}
@@ -0,0 +1,169 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/privatestate"
+)
+
+// privateKeyWriteOnlyHashes is the private state key holding hashes of the
+// write-only values last sent to the API.
+const privateKeyWriteOnlyHashes = "write_only_hashes"
+
+// writeOnlyHashes records SHA-256 hashes of the write-only values sent to
+// the API. Send headers are keyed by header name so the names can be
+// filtered out of the non-secret sendheaders attribute on Read, and Redis
+// host passwords by the key of the host in the hosts parameter.
+type writeOnlyHashes struct {
+	Password      string            `json:"password,omitempty"`
+	SNMPCom       string            `json:"snmpcom,omitempty"`
+	SendHeaders   map[string]string `json:"sendheaders,omitempty"`
+	HostPasswords map[string]string `json:"host_passwords,omitempty"`
+}
+
+// applyWriteOnlyConfig merges write-only values from config into the request
+// and returns the hashes of what was sent. Write-only values are only
+// available in config, never in plan or state.
+func applyWriteOnlyConfig(ctx context.Context, config tfsdk.Config, req *client.CheckCreateRequest, diags *diag.Diagnostics) writeOnlyHashes {
+	var hashes writeOnlyHashes
+
+	var password types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	if !password.IsNull() && !password.IsUnknown() {
+		req.Password = password.ValueString()
+		hashes.Password = privatestate.HashSecret(req.Password)
+	}
+
+	var snmpCom types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("snmpcom_wo"), &snmpCom)...)
+	if !snmpCom.IsNull() && !snmpCom.IsUnknown() {
+		req.SNMPCom = snmpCom.ValueString()
+		hashes.SNMPCom = privatestate.HashSecret(req.SNMPCom)
+	}
+
+	var sendHeaders types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("sendheaders_wo"), &sendHeaders)...)
+	if !sendHeaders.IsNull() && !sendHeaders.IsUnknown() {
+		headers := make(map[string]string)
+		diags.Append(sendHeaders.ElementsAs(ctx, &headers, false)...)
+		if req.SendHeaders == nil {
+			req.SendHeaders = make(map[string]string, len(headers))
+		}
+		hashes.SendHeaders = make(map[string]string, len(headers))
+		for name, value := range headers {
+			req.SendHeaders[name] = value
+			hashes.SendHeaders[name] = privatestate.HashSecret(value)
+		}
+	}
+
+	var hostPasswords types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("host_passwords_wo"), &hostPasswords)...)
+	if !hostPasswords.IsNull() && !hostPasswords.IsUnknown() {
+		passwords := make(map[string]string)
+		diags.Append(hostPasswords.ElementsAs(ctx, &passwords, false)...)
+		hashes.HostPasswords = make(map[string]string, len(passwords))
+		for key, value := range passwords {
+			setHostPassword(req, key, value)
+			hashes.HostPasswords[key] = privatestate.HashSecret(value)
+		}
+	}
+
+	return hashes
+}
+
+// setHostPassword merges a Redis host password into the hosts parameter of
+// the request's extra parameters, next to the host configured there.
+func setHostPassword(req *client.CheckCreateRequest, key, password string) {
+	if req.ExtraParameters == nil {
+		req.ExtraParameters = make(map[string]interface{})
+	}
+	hosts, ok := req.ExtraParameters["hosts"].(map[string]interface{})
+	if !ok {
+		hosts = make(map[string]interface{})
+		req.ExtraParameters["hosts"] = hosts
+	}
+	host, ok := hosts[key].(map[string]interface{})
+	if !ok {
+		host = make(map[string]interface{})
+		hosts[key] = host
+	}
+	host["password"] = password
+}
+
+func getWriteOnlyHashes(ctx context.Context, private privatestate.Store, diags *diag.Diagnostics) writeOnlyHashes {
+	var hashes writeOnlyHashes
+	if !privatestate.GetJSON(ctx, private, privateKeyWriteOnlyHashes, &hashes, diags) {
+		return writeOnlyHashes{}
+	}
+	return hashes
+}
+
+func setWriteOnlyHashes(ctx context.Context, private privatestate.Store, hashes writeOnlyHashes, diags *diag.Diagnostics) {
+	privatestate.SetJSON(ctx, private, privateKeyWriteOnlyHashes, hashes, diags)
+}
+
+// applyWriteOnlyState removes secret send headers from the model and clears
+// the *_wo_version attribute of any write-only value that the API echoes
+// back with a different hash. The cleared version shows up as a diff on the
+// next plan, so the configured value is sent again.
+func applyWriteOnlyState(ctx context.Context, check *client.Check, hashes writeOnlyHashes, model *CheckResourceModel) {
+	if len(hashes.SendHeaders) > 0 {
+		visible := make(map[string]string)
+		for name, value := range check.Parameters.SendHeaders {
+			if _, secret := hashes.SendHeaders[name]; !secret {
+				visible[name] = value
+			}
+		}
+		if len(visible) > 0 {
+			model.SendHeaders, _ = types.MapValueFrom(ctx, types.StringType, visible)
+		} else {
+			model.SendHeaders = types.MapNull(types.StringType)
+		}
+	}
+
+	if writeOnlyDrifted(hashes.Password, check.Parameters.Password) {
+		tflog.Info(ctx, "Write-only password changed outside Terraform")
+		model.PasswordWOVersion = types.Int64Null()
+	}
+
+	if writeOnlyDrifted(hashes.SNMPCom, check.Parameters.SNMPCom) {
+		tflog.Info(ctx, "Write-only SNMP community changed outside Terraform")
+		model.SNMPComWOVersion = types.Int64Null()
+	}
+
+	for key, hash := range hashes.HostPasswords {
+		if writeOnlyDrifted(hash, check.Parameters.Hosts[key].Password) {
+			tflog.Info(ctx, "Write-only Redis host password changed outside Terraform", map[string]interface{}{
+				"host": key,
+			})
+			model.HostPasswordsWOVersion = types.Int64Null()
+			break
+		}
+	}
+
+	if len(check.Parameters.SendHeaders) == 0 {
+		return
+	}
+	for name, hash := range hashes.SendHeaders {
+		if value, ok := check.Parameters.SendHeaders[name]; !ok || privatestate.HashSecret(value) != hash {
+			tflog.Info(ctx, "Write-only send header changed outside Terraform", map[string]interface{}{
+				"header": name,
+			})
+			model.SendHeadersWOVersion = types.Int64Null()
+			break
+		}
+	}
+}
+
+// writeOnlyDrifted reports whether an echoed API value no longer matches the
+// stored hash. Values the API does not echo cannot be compared.
+func writeOnlyDrifted(hash, echoed string) bool {
+	return hash != "" && echoed != "" && privatestate.HashSecret(echoed) != hash
+}
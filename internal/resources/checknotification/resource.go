@@ -0,0 +1,363 @@
+package checknotification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
+)
+
+var (
+	_ resource.Resource                = &CheckNotificationResource{}
+	_ resource.ResourceWithConfigure   = &CheckNotificationResource{}
+	_ resource.ResourceWithImportState = &CheckNotificationResource{}
+)
+
+type CheckNotificationResource struct {
+	client *client.Client
+}
+
+func NewCheckNotificationResource() resource.Resource {
+	return &CheckNotificationResource{}
+}
+
+func (r *CheckNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_notification"
+}
+
+func (r *CheckNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = CheckNotificationSchema()
+}
+
+func (r *CheckNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+}
+
+func (r *CheckNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CheckNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := plan.CheckID.ValueString()
+	contactID := plan.ContactID.ValueString()
+	tflog.Debug(ctx, "Creating check notification", map[string]interface{}{
+		"check_id":   checkID,
+		"contact_id": contactID,
+	})
+
+	unlock := r.client.Lock("check", checkID)
+	defer unlock()
+
+	check, err := r.client.GetCheck(ctx, checkID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Check Notification",
+			"Could not read check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	if _, _, ok := findNotification(check.Notifications, contactID); ok {
+		resp.Diagnostics.AddError(
+			"Check Notification Already Exists",
+			fmt.Sprintf("Contact %s is already attached to check %s. Import it with ID %s/%s to manage it.", contactID, checkID, checkID, contactID),
+		)
+		return
+	}
+
+	notifications := make([]map[string]interface{}, 0, len(check.Notifications)+1)
+	notifications = append(notifications, check.Notifications...)
+	notifications = append(notifications, notificationEntry(&plan))
+	_, err = r.client.UpdateCheckNotifications(ctx, checkID, check.Type, notifications)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Check Notification",
+			"Could not attach contact "+contactID+" to check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(checkID + "/" + contactID)
+
+	tflog.Debug(ctx, "Created check notification", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CheckNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CheckNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := state.CheckID.ValueString()
+	contactID := state.ContactID.ValueString()
+	tflog.Debug(ctx, "Reading check notification", map[string]interface{}{
+		"check_id":   checkID,
+		"contact_id": contactID,
+	})
+
+	check, err := r.client.GetCheck(ctx, checkID)
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			tflog.Debug(ctx, "Check not found, removing notification from state", map[string]interface{}{
+				"check_id": checkID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Check Notification",
+			"Could not read check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	delay, schedule, ok := findNotification(check.Notifications, contactID)
+	if !ok {
+		tflog.Debug(ctx, "Check notification not found, removing from state", map[string]interface{}{
+			"check_id":   checkID,
+			"contact_id": contactID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(checkID + "/" + contactID)
+	state.Delay = types.Int64Value(delay)
+	state.Schedule = types.StringValue(schedule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CheckNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CheckNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := plan.CheckID.ValueString()
+	contactID := plan.ContactID.ValueString()
+	tflog.Debug(ctx, "Updating check notification", map[string]interface{}{
+		"check_id":   checkID,
+		"contact_id": contactID,
+	})
+
+	unlock := r.client.Lock("check", checkID)
+	defer unlock()
+
+	check, err := r.client.GetCheck(ctx, checkID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Check Notification",
+			"Could not read check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Keep the entry's position so the rest of the list is sent back as read
+	notifications := make([]map[string]interface{}, 0, len(check.Notifications)+1)
+	replaced := false
+	for _, n := range check.Notifications {
+		if _, ok := n[contactID]; ok && !replaced {
+			notifications = append(notifications, notificationEntry(&plan))
+			replaced = true
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+	if !replaced {
+		notifications = append(notifications, notificationEntry(&plan))
+	}
+
+	_, err = r.client.UpdateCheckNotifications(ctx, checkID, check.Type, notifications)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Check Notification",
+			"Could not update contact "+contactID+" on check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(checkID + "/" + contactID)
+
+	tflog.Debug(ctx, "Updated check notification", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CheckNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CheckNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := state.CheckID.ValueString()
+	contactID := state.ContactID.ValueString()
+	tflog.Debug(ctx, "Deleting check notification", map[string]interface{}{
+		"check_id":   checkID,
+		"contact_id": contactID,
+	})
+
+	unlock := r.client.Lock("check", checkID)
+	defer unlock()
+
+	check, err := r.client.GetCheck(ctx, checkID)
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Check Notification",
+			"Could not read check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+	if _, _, ok := findNotification(check.Notifications, contactID); !ok {
+		return
+	}
+
+	_, err = r.client.UpdateCheckNotifications(ctx, checkID, check.Type, withoutNotification(check.Notifications, contactID))
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Check Notification",
+			"Could not detach contact "+contactID+" from check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted check notification", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *CheckNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'check_id/contact_id', got: %s", req.ID),
+		)
+		return
+	}
+	checkID, contactID := idParts[0], idParts[1]
+
+	tflog.Debug(ctx, "Importing check notification", map[string]interface{}{
+		"check_id":   checkID,
+		"contact_id": contactID,
+	})
+
+	check, err := r.client.GetCheck(ctx, checkID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Check Notification",
+			"Could not read check ID "+checkID+": "+err.Error(),
+		)
+		return
+	}
+
+	delay, schedule, ok := findNotification(check.Notifications, contactID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error Importing Check Notification",
+			"Contact "+contactID+" is not attached to check ID "+checkID+".",
+		)
+		return
+	}
+
+	state := CheckNotificationResourceModel{
+		ID:        types.StringValue(checkID + "/" + contactID),
+		CheckID:   types.StringValue(checkID),
+		ContactID: types.StringValue(contactID),
+		Delay:     types.Int64Value(delay),
+		Schedule:  types.StringValue(schedule),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func notificationEntry(model *CheckNotificationResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		model.ContactID.ValueString(): map[string]interface{}{
+			"delay":    int(model.Delay.ValueInt64()),
+			"schedule": model.Schedule.ValueString(),
+		},
+	}
+}
+
+// findNotification returns the delay and schedule the check uses for a
+// contact, defaulting the schedule to "All" like nodeping_check does.
+func findNotification(notifications []map[string]interface{}, contactID string) (int64, string, bool) {
+	for _, n := range notifications {
+		config, ok := n[contactID]
+		if !ok {
+			continue
+		}
+		var delay int64
+		schedule := "All"
+		if configMap, ok := config.(map[string]interface{}); ok {
+			if d, ok := configMap["delay"].(float64); ok {
+				delay = int64(d)
+			}
+			if s, ok := configMap["schedule"].(string); ok {
+				schedule = s
+			}
+		}
+		return delay, schedule, true
+	}
+	return 0, "", false
+}
+
+// withoutNotification returns the notification list with every entry for
+// contactID removed, leaving the other entries as read.
+func withoutNotification(notifications []map[string]interface{}, contactID string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, n := range notifications {
+		if _, ok := n[contactID]; !ok {
+			result = append(result, n)
+			continue
+		}
+		if len(n) == 1 {
+			continue
+		}
+		rest := make(map[string]interface{}, len(n)-1)
+		for id, config := range n {
+			if id != contactID {
+				rest[id] = config
+			}
+		}
+		result = append(result, rest)
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package checknotification
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CheckNotificationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	CheckID   types.String `tfsdk:"check_id"`
+	ContactID types.String `tfsdk:"contact_id"`
+	Delay     types.Int64  `tfsdk:"delay"`
+	Schedule  types.String `tfsdk:"schedule"`
+}
+
+func CheckNotificationSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Attaches a single contact or contact group to an existing NodePing check.",
+		MarkdownDescription: `
+Attaches a single contact or contact group to an existing NodePing check.
+
+Use this resource when the team that receives alerts is not the team that owns the check. Each notification is added, updated and removed on its own; other notifications on the check are left untouched.
+
+When the check is managed by ` + "`nodeping_check`" + `, set ` + "`manage_notifications = false`" + ` on it so its inline ` + "`notifications`" + ` blocks do not fight with this resource.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "nodeping_check" "api" {
+  type                 = "HTTP"
+  target               = "https://api.example.com/health"
+  manage_notifications = false
+}
+
+resource "nodeping_check_notification" "oncall" {
+  check_id   = nodeping_check.api.id
+  contact_id = "K5SP9CQP"
+  delay      = 5
+  schedule   = "All"
+}
+` + "```" + `
+
+## Import
+
+Check notifications can be imported using ` + "`check_id/contact_id`" + `:
+
+` + "```shell" + `
+terraform import nodeping_check_notification.example 201205050153W2Q4C-0J2HSIRF/K5SP9CQP
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the notification, in the form check_id/contact_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Description: "The ID of the check to attach the notification to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact_id": schema.StringAttribute{
+				Description: "Contact address ID or contact group ID to notify.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delay": schema.Int64Attribute{
+				Description: "Delay in minutes before sending the notification. Defaults to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Notification schedule name. Defaults to 'All'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("All"),
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package checknotification
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithModifyPlan = &CheckNotificationResource{}
+
+// ModifyPlan resolves contact_id and schedule against the account, sharing
+// the client's per-run cache with nodeping_check.
+func (r *CheckNotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CheckNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ContactID.IsUnknown() && plan.Schedule.IsUnknown() {
+		return
+	}
+
+	targets, err := r.client.NotificationTargets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Notification",
+			"Could not list contacts, contact groups and schedules, so contact_id and schedule were not checked: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.ContactID.IsUnknown() {
+		if err := targets.ValidateContactID(plan.ContactID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("contact_id"), "Unknown Notification Contact", err.Error())
+		}
+	}
+	if !plan.Schedule.IsUnknown() {
+		if err := targets.ValidateSchedule(plan.Schedule.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("schedule"), "Unknown Notification Schedule", err.Error())
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithModifyPlan     = &ContactResource{}
+	_ resource.ResourceWithValidateConfig = &ContactResource{}
+)
+
+// addressIdentity returns the value that identifies an address across plans:
+// its user-supplied key when set, otherwise its type and address. The second
+// return value is false while the identity is not yet known.
+func addressIdentity(addr AddressModel) (string, bool) {
+	if !addr.Key.IsNull() {
+		if addr.Key.IsUnknown() {
+			return "", false
+		}
+		return "key:" + addr.Key.ValueString(), true
+	}
+	if addr.Type.IsUnknown() || addr.Address.IsUnknown() {
+		return "", false
+	}
+	return "addr:" + addressKey(addr.Type.ValueString(), addr.Address.ValueString()), true
+}
+
+func (r *ContactResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ContactResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(config.Addresses))
+	for i, addr := range config.Addresses {
+		identity, known := addressIdentity(addr)
+		if !known {
+			continue
+		}
+		if seen[identity] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("address").AtListIndex(i),
+				"Duplicate Contact Address",
+				"Each address block must have a unique key, or a unique type and address when key is not set.",
+			)
+			continue
+		}
+		seen[identity] = true
+	}
+}
+
+// ModifyPlan carries each address ID over from state by identity rather
+// than list position, so reordering or inserting address blocks never
+// reassigns an existing ID to a different address. Destroy plans are left to
+// planDestroyProtection.
+func (r *ContactResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if planDestroyProtection(ctx, req, &resp.Diagnostics) {
+		return
+	}
+
+	var plan ContactResourceModel
+	var state ContactResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateIDs := make(map[string]types.String, len(state.Addresses))
+	unkeyedIDs := make(map[string]types.String, len(state.Addresses))
+	for _, addr := range state.Addresses {
+		if identity, known := addressIdentity(addr); known {
+			stateIDs[identity] = addr.ID
+		}
+		if addr.Key.IsNull() {
+			unkeyedIDs[addressKey(addr.Type.ValueString(), addr.Address.ValueString())] = addr.ID
+		}
+	}
+
+	// Exact identities are matched first, so an address gaining a key can
+	// never take the ID of an address that still matches exactly.
+	used := make(map[string]bool, len(plan.Addresses))
+	pending := make([]int, 0, len(plan.Addresses))
+	for i := range plan.Addresses {
+		addr := plan.Addresses[i]
+		plan.Addresses[i].ID = types.StringUnknown()
+
+		identity, known := addressIdentity(addr)
+		if !known {
+			continue
+		}
+		if id, ok := stateIDs[identity]; ok && !used[id.ValueString()] {
+			used[id.ValueString()] = true
+			plan.Addresses[i].ID = id
+			continue
+		}
+		if !addr.Key.IsNull() && !addr.Type.IsUnknown() && !addr.Address.IsUnknown() {
+			pending = append(pending, i)
+		}
+	}
+
+	// Adding a key to an existing address keeps its ID
+	for _, i := range pending {
+		addr := plan.Addresses[i]
+		if id, ok := unkeyedIDs[addressKey(addr.Type.ValueString(), addr.Address.ValueString())]; ok && !used[id.ValueString()] {
+			used[id.ValueString()] = true
+			plan.Addresses[i].ID = id
+		}
+	}
+
+	warnExternalAddresses(ctx, req.Private, state, plan, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
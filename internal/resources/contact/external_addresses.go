@@ -0,0 +1,80 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/privatestate"
+)
+
+// privateKeyAddressIDs is the private state key holding the IDs of the
+// addresses last written from address blocks.
+const privateKeyAddressIDs = "address_ids"
+
+// setWrittenAddresses records the IDs of the addresses that came from
+// address blocks, or removes the record when the resource does not manage
+// the contact's full address list.
+func setWrittenAddresses(ctx context.Context, private privatestate.Store, plan ContactResourceModel, configAddresses configAddressMap, diags *diag.Diagnostics) {
+	if !addressesManaged(plan) {
+		diags.Append(private.SetKey(ctx, privateKeyAddressIDs, nil)...)
+		return
+	}
+
+	ids := make([]string, 0, len(plan.Addresses))
+	for _, addr := range plan.Addresses {
+		if _, _, ok := configAddresses.lookup(addr); ok {
+			ids = append(ids, addr.ID.ValueString())
+		}
+	}
+	sort.Strings(ids)
+	privatestate.SetJSON(ctx, private, privateKeyAddressIDs, ids, diags)
+}
+
+// warnExternalAddresses warns when a plan removes addresses that this
+// resource never wrote, which were most likely added by
+// nodeping_contact_address. With manage_addresses on, every apply would
+// remove them again. Contacts without a record, such as imported ones, are
+// not checked.
+func warnExternalAddresses(ctx context.Context, private privatestate.Store, state, plan ContactResourceModel, diags *diag.Diagnostics) {
+	if !addressesManaged(plan) {
+		return
+	}
+	var written []string
+	if !privatestate.GetJSON(ctx, private, privateKeyAddressIDs, &written, diags) {
+		return
+	}
+
+	kept := make(map[string]bool, len(written)+len(plan.Addresses))
+	for _, id := range written {
+		kept[id] = true
+	}
+	for _, addr := range plan.Addresses {
+		if !addr.ID.IsUnknown() {
+			kept[addr.ID.ValueString()] = true
+		}
+	}
+
+	var external []string
+	for _, addr := range state.Addresses {
+		if !kept[addr.ID.ValueString()] {
+			external = append(external, addr.Type.ValueString()+" "+addr.Address.ValueString())
+		}
+	}
+	if len(external) == 0 {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("manage_addresses"),
+		"Addresses Added Outside This Resource Will Be Removed",
+		fmt.Sprintf("Contact %s has addresses that this resource did not add, for example with nodeping_contact_address: %s. "+
+			"manage_addresses is true, so applying this plan removes them, and every later apply removes them again. "+
+			"Set manage_addresses = false to leave them in place.",
+			state.ID.ValueString(), strings.Join(external, ", ")),
+	)
+}
@@ -0,0 +1,41 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// planDestroyProtection fails the destroy plan itself, rather than the
+// apply, for protected contacts. It reports true when the plan is a
+// destroy, so ModifyPlan has nothing further to do.
+func planDestroyProtection(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) bool {
+	if !req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	var state ContactResourceModel
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return true
+	}
+	checkDeletionProtection(state, diags)
+	return true
+}
+
+// checkDeletionProtection adds an error and reports true when the contact
+// in state has deletion_protection set. It is read from state, so lifting
+// the protection takes an apply of its own.
+func checkDeletionProtection(state ContactResourceModel, diags *diag.Diagnostics) bool {
+	if !state.DeletionProtection.ValueBool() {
+		return false
+	}
+
+	diags.AddError(
+		"Contact Is Protected From Deletion",
+		fmt.Sprintf("Contact ID %s (%s) has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString(), state.Name.ValueString()),
+	)
+	return true
+}
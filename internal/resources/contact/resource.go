@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/planmodifiers"
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
 )
 
 var (
@@ -33,7 +36,7 @@ func (r *ContactResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = ContactSchema()
+	resp.Schema = ContactSchema(ctx)
 }
 
 func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,16 +44,16 @@ func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
 }
 
 func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -60,6 +63,20 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	var config ContactResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating contact", map[string]interface{}{
 		"name": plan.Name.ValueString(),
 	})
@@ -69,6 +86,8 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 		CustRole: plan.CustRole.ValueString(),
 	}
 
+	configAddresses := configAddressesByIdentity(config.Addresses)
+	pendingSecrets := make(map[string]addressSecretHashes)
 	for _, addr := range plan.Addresses {
 		newAddr := client.NewAddress{
 			Address:       addr.Address.ValueString(),
@@ -107,6 +126,12 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 			newAddr.QueryStrings = qs
 		}
+		if identity, configAddr, ok := configAddresses.lookup(addr); ok {
+			pendingSecrets[identity] = mergeAddressSecrets(ctx, configAddr, &newAddr.Headers, &newAddr.QueryStrings, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
 
 		createReq.NewAddresses = append(createReq.NewAddresses, newAddr)
 	}
@@ -123,11 +148,18 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.ID = types.StringValue(contact.ID)
 	plan.CustomerID = types.StringValue(contact.CustomerID)
 
-	plan.Addresses = mapAddressesToModel(ctx, contact.Addresses, plan.Addresses, &resp.Diagnostics)
+	plan.Addresses = mapAddressesToModel(ctx, contact.Addresses, plan.Addresses, addressesManaged(plan), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	secretHashes := resolveAddressSecretHashes(plan.Addresses, pendingSecrets)
+	applyAddressSecretState(ctx, contact.Addresses, secretHashes, plan.Addresses)
+	setAddressSecretHashes(ctx, resp.Private, secretHashes, &resp.Diagnostics)
+	setWrittenAddresses(ctx, resp.Private, plan, configAddresses, &resp.Diagnostics)
+
+	r.client.InvalidateNotificationTargets()
+
 	tflog.Debug(ctx, "Created contact", map[string]interface{}{
 		"id": contact.ID,
 	})
@@ -142,6 +174,14 @@ func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Reading contact", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
@@ -166,29 +206,52 @@ func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.CustomerID = types.StringValue(contact.CustomerID)
 	state.Name = types.StringValue(contact.Name)
 	state.CustRole = types.StringValue(contact.CustRole)
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.ManageAddresses.IsNull() {
+		state.ManageAddresses = types.BoolValue(true)
+	}
 
-	state.Addresses = mapAddressesToModel(ctx, contact.Addresses, state.Addresses, &resp.Diagnostics)
+	state.Addresses = mapAddressesToModel(ctx, contact.Addresses, state.Addresses, addressesManaged(state), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	secretHashes := getAddressSecretHashes(ctx, req.Private, &resp.Diagnostics)
+	applyAddressSecretState(ctx, contact.Addresses, secretHashes, state.Addresses)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan ContactResourceModel
 	var state ContactResourceModel
+	var config ContactResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Updating contact", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
 
+	// Serialize with nodeping_contact_address resources editing the same contact
+	unlock := r.client.Lock("contact", state.ID.ValueString())
+	defer unlock()
+
 	updateReq := client.ContactUpdateRequest{
 		Name:     plan.Name.ValueString(),
 		CustRole: plan.CustRole.ValueString(),
@@ -201,7 +264,28 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// NodePing drops every existing address missing from the update. Unless
+	// this resource owns the full list, addresses it never tracked, such as
+	// those managed by nodeping_contact_address, are sent back unchanged.
 	updateReq.Addresses = make(map[string]client.ContactAddress)
+	if !addressesManaged(plan) {
+		current, err := r.client.GetContact(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Contact",
+				"Could not read contact ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		for id, addr := range current.Addresses {
+			if !existingAddressIDs[id] {
+				updateReq.Addresses[id] = addr
+			}
+		}
+	}
+
+	configAddresses := configAddressesByIdentity(config.Addresses)
+	pendingSecrets := make(map[string]addressSecretHashes)
 	for _, addr := range plan.Addresses {
 		if !addr.ID.IsNull() && !addr.ID.IsUnknown() && existingAddressIDs[addr.ID.ValueString()] {
 			addrUpdate := client.ContactAddress{
@@ -244,6 +328,12 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 				}
 				addrUpdate.QueryStrings = qs
 			}
+			if identity, configAddr, ok := configAddresses.lookup(addr); ok {
+				pendingSecrets[identity] = mergeAddressSecrets(ctx, configAddr, &addrUpdate.Headers, &addrUpdate.QueryStrings, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
 
 			updateReq.Addresses[addr.ID.ValueString()] = addrUpdate
 		} else {
@@ -284,6 +374,12 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 				}
 				newAddr.QueryStrings = qs
 			}
+			if identity, configAddr, ok := configAddresses.lookup(addr); ok {
+				pendingSecrets[identity] = mergeAddressSecrets(ctx, configAddr, &newAddr.Headers, &newAddr.QueryStrings, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
 
 			updateReq.NewAddresses = append(updateReq.NewAddresses, newAddr)
 		}
@@ -301,11 +397,18 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.ID = types.StringValue(contact.ID)
 	plan.CustomerID = types.StringValue(contact.CustomerID)
 
-	plan.Addresses = mapAddressesToModel(ctx, contact.Addresses, plan.Addresses, &resp.Diagnostics)
+	plan.Addresses = mapAddressesToModel(ctx, contact.Addresses, plan.Addresses, addressesManaged(plan), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	secretHashes := resolveAddressSecretHashes(plan.Addresses, pendingSecrets)
+	applyAddressSecretState(ctx, contact.Addresses, secretHashes, plan.Addresses)
+	setAddressSecretHashes(ctx, resp.Private, secretHashes, &resp.Diagnostics)
+	setWrittenAddresses(ctx, resp.Private, plan, configAddresses, &resp.Diagnostics)
+
+	r.client.InvalidateNotificationTargets()
+
 	tflog.Debug(ctx, "Updated contact", map[string]interface{}{
 		"id": contact.ID,
 	})
@@ -320,6 +423,18 @@ func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if checkDeletionProtection(state, &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting contact", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
@@ -336,6 +451,8 @@ func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	r.client.InvalidateNotificationTargets()
+
 	tflog.Debug(ctx, "Deleted contact", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
@@ -384,9 +501,13 @@ func (r *ContactResource) ImportState(ctx context.Context, req resource.ImportSt
 		CustomerID: types.StringValue(contact.CustomerID),
 		Name:       types.StringValue(contact.Name),
 		CustRole:   types.StringValue(contact.CustRole),
+		Timeouts:   nullTimeouts(),
+
+		DeletionProtection: types.BoolValue(false),
+		ManageAddresses:    types.BoolValue(true),
 	}
 
-	state.Addresses = mapAddressesToModel(ctx, contact.Addresses, nil, &resp.Diagnostics)
+	state.Addresses = mapAddressesToModel(ctx, contact.Addresses, nil, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -394,121 +515,201 @@ func (r *ContactResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func mapAddressesToModel(ctx context.Context, apiAddresses map[string]client.ContactAddress, planAddresses []AddressModel, diags *diag.Diagnostics) []AddressModel {
+// addressesManaged reports whether the address blocks own the contact's
+// full address list. Unset or unknown values count as managed, which is the
+// default.
+func addressesManaged(model ContactResourceModel) bool {
+	if model.ManageAddresses.IsNull() || model.ManageAddresses.IsUnknown() {
+		return true
+	}
+	return model.ManageAddresses.ValueBool()
+}
+
+// mapAddressesToModel converts API addresses to models, ordered like the
+// planned (or prior state) addresses. Each planned address is matched to an
+// API address by its known ID, then by type and address. API addresses that
+// match nothing are appended in ID order when includeUnmatched is set, as
+// with manage_addresses, and skipped otherwise as belonging to someone else.
+func mapAddressesToModel(ctx context.Context, apiAddresses map[string]client.ContactAddress, planAddresses []AddressModel, includeUnmatched bool, diags *diag.Diagnostics) []AddressModel {
 	if len(apiAddresses) == 0 {
 		return nil
 	}
 
 	result := make([]AddressModel, 0, len(apiAddresses))
-
-	planAddrByAddress := make(map[string]AddressModel)
-	for _, addr := range planAddresses {
-		key := addr.Type.ValueString() + ":" + addr.Address.ValueString()
-		planAddrByAddress[key] = addr
+	matched := make(map[string]bool, len(apiAddresses))
+
+	// Known IDs are claimed first, so a new address with the same type and
+	// address as a later one can never take that address's ID.
+	ids := make([]string, len(planAddresses))
+	for i, planAddr := range planAddresses {
+		if planAddr.ID.IsNull() || planAddr.ID.IsUnknown() {
+			continue
+		}
+		if id := planAddr.ID.ValueString(); !matched[id] {
+			if _, ok := apiAddresses[id]; ok {
+				ids[i] = id
+				matched[id] = true
+			}
+		}
+	}
+	for i, planAddr := range planAddresses {
+		if ids[i] == "" {
+			if ids[i] = matchAPIAddress(apiAddresses, planAddr, matched); ids[i] != "" {
+				matched[ids[i]] = true
+			}
+		}
 	}
 
-	for id, addr := range apiAddresses {
-		model := AddressModel{
-			ID:            types.StringValue(id),
-			Type:          types.StringValue(addr.Type),
-			Address:       types.StringValue(addr.Address),
-			SuppressUp:    types.BoolValue(addr.SuppressUp),
-			SuppressDown:  types.BoolValue(addr.SuppressDown),
-			SuppressFirst: types.BoolValue(addr.SuppressFirst),
-			SuppressDiag:  types.BoolValue(addr.SuppressDiag),
-			SuppressAll:   types.BoolValue(addr.SuppressAll),
-			Mute:          types.BoolValue(false),
+	for i, planAddr := range planAddresses {
+		id := ids[i]
+		if id == "" {
+			continue
 		}
 
-		if addr.Mute != nil {
-			var muteVal interface{}
-			if err := json.Unmarshal(addr.Mute, &muteVal); err == nil {
-				switch v := muteVal.(type) {
-				case bool:
-					model.Mute = types.BoolValue(v)
-				case float64:
-					model.Mute = types.BoolValue(v > 0)
-				}
-			}
+		model := AddressModelFromAPI(ctx, id, apiAddresses[id])
+		// key and secrets_version are configuration only; the API has no equivalent
+		model.Key = planAddr.Key
+		model.SecretsVersion = planAddr.SecretsVersion
+		result = append(result, model)
+	}
+
+	if !includeUnmatched {
+		if len(result) == 0 {
+			return nil
 		}
+		return result
+	}
 
-		if addr.Action != "" {
-			model.Action = types.StringValue(addr.Action)
-		} else {
-			model.Action = types.StringNull()
+	remaining := make([]string, 0, len(apiAddresses)-len(matched))
+	for id := range apiAddresses {
+		if !matched[id] {
+			remaining = append(remaining, id)
 		}
+	}
+	sort.Strings(remaining)
 
-		if addr.Data != nil {
-			// Data can be a string or an object from the API
-			// Always normalize to compact JSON for consistent comparison
-			switch v := addr.Data.(type) {
-			case string:
-				if v != "" {
-					// Try to normalize JSON string to compact form
-					model.Data = types.StringValue(normalizeJSONString(v))
-				} else {
-					model.Data = types.StringNull()
-				}
-			case map[string]interface{}:
-				// Convert object to compact JSON string
-				jsonBytes, err := json.Marshal(v)
-				if err == nil {
-					model.Data = types.StringValue(string(jsonBytes))
-				} else {
-					model.Data = types.StringNull()
-				}
-			default:
-				// Try to marshal whatever it is to compact JSON
-				jsonBytes, err := json.Marshal(v)
-				if err == nil {
-					model.Data = types.StringValue(string(jsonBytes))
-				} else {
-					model.Data = types.StringNull()
-				}
-			}
-		} else {
-			model.Data = types.StringNull()
+	for _, id := range remaining {
+		model := AddressModelFromAPI(ctx, id, apiAddresses[id])
+		model.Key = types.StringNull()
+		model.SecretsVersion = types.Int64Null()
+		result = append(result, model)
+	}
+
+	return result
+}
+
+// matchAPIAddress returns the ID of the API address corresponding to a
+// planned address, or "" when there is none.
+func matchAPIAddress(apiAddresses map[string]client.ContactAddress, planAddr AddressModel, matched map[string]bool) string {
+	if !planAddr.ID.IsNull() && !planAddr.ID.IsUnknown() {
+		id := planAddr.ID.ValueString()
+		if _, ok := apiAddresses[id]; ok && !matched[id] {
+			return id
 		}
+	}
 
-		if addr.Priority != nil {
-			model.Priority = types.Int64Value(int64(*addr.Priority))
-		} else {
-			model.Priority = types.Int64Null()
+	key := addressKey(planAddr.Type.ValueString(), planAddr.Address.ValueString())
+	candidates := make([]string, 0, 1)
+	for id, addr := range apiAddresses {
+		if !matched[id] && addressKey(addr.Type, addr.Address) == key {
+			candidates = append(candidates, id)
 		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Strings(candidates)
+	return candidates[0]
+}
 
-		if len(addr.Headers) > 0 {
-			headers, _ := types.MapValueFrom(ctx, types.StringType, addr.Headers)
-			model.Headers = headers
-		} else {
-			model.Headers = types.MapNull(types.StringType)
+// AddressModelFromAPI converts an API address to a model. It is shared with
+// nodeping_contact_address, which manages a single address of a contact.
+func AddressModelFromAPI(ctx context.Context, id string, addr client.ContactAddress) AddressModel {
+	model := AddressModel{
+		ID:            types.StringValue(id),
+		Type:          types.StringValue(addr.Type),
+		Address:       types.StringValue(addr.Address),
+		SuppressUp:    types.BoolValue(addr.SuppressUp),
+		SuppressDown:  types.BoolValue(addr.SuppressDown),
+		SuppressFirst: types.BoolValue(addr.SuppressFirst),
+		SuppressDiag:  types.BoolValue(addr.SuppressDiag),
+		SuppressAll:   types.BoolValue(addr.SuppressAll),
+		Mute:          types.BoolValue(false),
+	}
+
+	if addr.Mute != nil {
+		var muteVal interface{}
+		if err := json.Unmarshal(addr.Mute, &muteVal); err == nil {
+			switch v := muteVal.(type) {
+			case bool:
+				model.Mute = types.BoolValue(v)
+			case float64:
+				model.Mute = types.BoolValue(v > 0)
+			}
 		}
+	}
 
-		if len(addr.QueryStrings) > 0 {
-			qs, _ := types.MapValueFrom(ctx, types.StringType, addr.QueryStrings)
-			model.QueryStrings = qs
-		} else {
-			model.QueryStrings = types.MapNull(types.StringType)
+	if addr.Action != "" {
+		model.Action = types.StringValue(addr.Action)
+	} else {
+		model.Action = types.StringNull()
+	}
+
+	if addr.Data != nil {
+		// Data can be a string or an object from the API
+		// Always normalize to compact JSON for consistent comparison
+		switch v := addr.Data.(type) {
+		case string:
+			if v != "" {
+				// Try to normalize JSON string to compact form
+				model.Data = types.StringValue(planmodifiers.NormalizeJSONString(v))
+			} else {
+				model.Data = types.StringNull()
+			}
+		case map[string]interface{}:
+			// Convert object to compact JSON string
+			jsonBytes, err := json.Marshal(v)
+			if err == nil {
+				model.Data = types.StringValue(string(jsonBytes))
+			} else {
+				model.Data = types.StringNull()
+			}
+		default:
+			// Try to marshal whatever it is to compact JSON
+			jsonBytes, err := json.Marshal(v)
+			if err == nil {
+				model.Data = types.StringValue(string(jsonBytes))
+			} else {
+				model.Data = types.StringNull()
+			}
 		}
+	} else {
+		model.Data = types.StringNull()
+	}
 
-		result = append(result, model)
+	if addr.Priority != nil {
+		model.Priority = types.Int64Value(int64(*addr.Priority))
+	} else {
+		model.Priority = types.Int64Null()
 	}
 
-	return result
-}
+	if len(addr.Headers) > 0 {
+		headers, _ := types.MapValueFrom(ctx, types.StringType, addr.Headers)
+		model.Headers = headers
+	} else {
+		model.Headers = types.MapNull(types.StringType)
+	}
 
-// normalizeJSONString attempts to normalize a JSON string to compact form.
-// If the string is valid JSON, it returns the compact representation.
-// If not valid JSON, it returns the original string unchanged.
-func normalizeJSONString(s string) string {
-	var v interface{}
-	if err := json.Unmarshal([]byte(s), &v); err != nil {
-		// Not valid JSON, return as-is
-		return s
-	}
-	// Re-marshal to compact JSON
-	compact, err := json.Marshal(v)
-	if err != nil {
-		return s
+	if len(addr.QueryStrings) > 0 {
+		qs, _ := types.MapValueFrom(ctx, types.StringType, addr.QueryStrings)
+		model.QueryStrings = qs
+	} else {
+		model.QueryStrings = types.MapNull(types.StringType)
 	}
-	return string(compact)
+
+	// Write-only secrets are never read back
+	model.SecretHeaders = types.MapNull(types.StringType)
+	model.SecretQueryStrings = types.MapNull(types.StringType)
+
+	return model
 }
@@ -1,6 +1,9 @@
 package contact
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -9,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/planmodifiers"
 )
 
 type ContactResourceModel struct {
@@ -17,10 +22,15 @@ type ContactResourceModel struct {
 	Name       types.String   `tfsdk:"name"`
 	CustRole   types.String   `tfsdk:"custrole"`
 	Addresses  []AddressModel `tfsdk:"address"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ManageAddresses    types.Bool `tfsdk:"manage_addresses"`
 }
 
 type AddressModel struct {
 	ID            types.String `tfsdk:"id"`
+	Key           types.String `tfsdk:"key"`
 	Type          types.String `tfsdk:"type"`
 	Address       types.String `tfsdk:"address"`
 	SuppressUp    types.Bool   `tfsdk:"suppress_up"`
@@ -34,9 +44,14 @@ type AddressModel struct {
 	QueryStrings  types.Map    `tfsdk:"querystrings"`
 	Data          types.String `tfsdk:"data"`
 	Priority      types.Int64  `tfsdk:"priority"`
+
+	// Write-only webhook secrets, never persisted to plan or state.
+	SecretHeaders      types.Map   `tfsdk:"secret_headers"`
+	SecretQueryStrings types.Map   `tfsdk:"secret_querystrings"`
+	SecretsVersion     types.Int64 `tfsdk:"secrets_version"`
 }
 
-func ContactSchema() schema.Schema {
+func ContactSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Manages a NodePing contact.",
 		MarkdownDescription: `
@@ -97,6 +112,31 @@ EOT
 }
 ` + "```" + `
 
+### Webhook with Secret Headers
+
+Bearer tokens and signing keys can be kept out of plans and state with the
+write-only ` + "`secret_headers`" + ` and ` + "`secret_querystrings`" + ` attributes
+(Terraform 1.11 or later). Bump ` + "`secrets_version`" + ` to send rotated values.
+
+` + "```hcl" + `
+resource "nodeping_contact" "pagerduty" {
+  name = "PagerDuty"
+
+  address {
+    type    = "webhook"
+    address = "https://events.example.com/v2/enqueue"
+    action  = "post"
+    headers = {
+      "Content-Type" = "application/json"
+    }
+    secret_headers = {
+      "Authorization" = "Token token=${var.pagerduty_token}"
+    }
+    secrets_version = 1
+  }
+}
+` + "```" + `
+
 **Note:** The ` + "`data`" + ` field stores the exact string as provided. When importing
 existing contacts, the API may return JSON with specific formatting (spaces after
 colons, embedded newlines). Use heredoc with ` + "`chomp()`" + ` to match the exact format
@@ -145,18 +185,43 @@ terraform import nodeping_contact.example 201205050153W2Q4C:201205050153W2Q4C-BK
 					stringvalidator.OneOf("edit", "view", "notify"),
 				},
 			},
+			"manage_addresses": schema.BoolAttribute{
+				Description:         "Whether the address blocks own the contact's full address list. When true, addresses added outside this resource show up as drift and are removed on the next apply, with a plan warning. Set to false when addresses are also attached with nodeping_contact_address resources; only the declared addresses are then managed. Defaults to true.",
+				MarkdownDescription: "Whether the `address` blocks own the contact's full address list. When `true`, addresses added outside this resource show up as drift and are removed on the next apply, with a plan warning. Set to `false` when addresses are also attached with `nodeping_contact_address` resources; only the declared addresses are then managed. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description:         "Prevent the contact from being destroyed. While true, destroying or replacing the contact fails; set it to false and apply first. Defaults to false.",
+				MarkdownDescription: "Prevent the contact from being destroyed. While `true`, destroying or replacing the contact fails; set it to `false` and apply first. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			// A list rather than a set: set blocks cannot contain the
+			// write-only secret attributes. ModifyPlan matches addresses by
+			// identity instead, so inserts and reorders plan like a set.
 			"address": schema.ListNestedBlock{
 				Description: "Contact addresses for receiving notifications.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Description: "The unique identifier of the address.",
+							Description: "The unique identifier of the address. Preserved across plans by matching the address's key, or its type and address.",
 							Computed:    true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
+						},
+						"key": schema.StringAttribute{
+							Description:         "Optional stable identifier for this address, unique within the contact. When set, the address keeps its ID even if its type or address value changes. Defaults to matching on type and address.",
+							MarkdownDescription: "Optional stable identifier for this address, unique within the contact. When set, the address keeps its ID even if its `type` or `address` value changes. Defaults to matching on `type` and `address`.",
+							Optional:            true,
 						},
 						"type": schema.StringAttribute{
 							Description: "The type of address. Valid values: 'email', 'sms', 'webhook', 'slack', 'hipchat', 'pushover', 'pagerduty', 'voice'.",
@@ -223,11 +288,35 @@ terraform import nodeping_contact.example 201205050153W2Q4C:201205050153W2Q4C-BK
 							Optional:    true,
 							ElementType: types.StringType,
 						},
+						"secret_headers": schema.MapAttribute{
+							Description:         "Write-only HTTP headers for webhook addresses, e.g. bearer tokens. Merged into headers and never stored in plan or state. Requires Terraform 1.11 or later.",
+							MarkdownDescription: "Write-only HTTP headers for webhook addresses, e.g. bearer tokens. Merged into `headers` and never stored in plan or state. Requires Terraform 1.11 or later.",
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							ElementType:         types.StringType,
+						},
+						"secret_querystrings": schema.MapAttribute{
+							Description:         "Write-only query string parameters for webhook addresses, e.g. signing keys. Merged into querystrings and never stored in plan or state. Requires Terraform 1.11 or later.",
+							MarkdownDescription: "Write-only query string parameters for webhook addresses, e.g. signing keys. Merged into `querystrings` and never stored in plan or state. Requires Terraform 1.11 or later.",
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							ElementType:         types.StringType,
+						},
+						"secrets_version": schema.Int64Attribute{
+							Description:         "Version of secret_headers and secret_querystrings. Change this value to send rotated secrets.",
+							MarkdownDescription: "Version of `secret_headers` and `secret_querystrings`. Change this value to send rotated secrets.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								secretsVersionValidator{},
+							},
+						},
 						"data": schema.StringAttribute{
 							Description: "Request body for webhook addresses (POST/PUT).",
 							Optional:    true,
 							PlanModifiers: []planmodifier.String{
-								JSONSemanticEqual(),
+								planmodifiers.JSONSemanticEqual(),
 							},
 						},
 						"priority": schema.Int64Attribute{
@@ -0,0 +1,209 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/privatestate"
+)
+
+// privateKeyAddressSecrets is the private state key holding hashes of the
+// secret headers and query strings last sent for each address ID.
+const privateKeyAddressSecrets = "address_secret_hashes"
+
+// addressSecretHashes records SHA-256 hashes of the secret values sent for a
+// single address, keyed by header or query string name.
+type addressSecretHashes struct {
+	Headers      map[string]string `json:"headers,omitempty"`
+	QueryStrings map[string]string `json:"querystrings,omitempty"`
+}
+
+func (h addressSecretHashes) empty() bool {
+	return len(h.Headers) == 0 && len(h.QueryStrings) == 0
+}
+
+// addressKey identifies an address by type and value, which stay stable
+// while NodePing assigns the address ID.
+func addressKey(addressType, address string) string {
+	return addressType + ":" + address
+}
+
+// mergeAddressSecrets merges the write-only secret_headers and
+// secret_querystrings of a configured address into the outgoing maps and
+// returns the hashes of what was merged.
+func mergeAddressSecrets(ctx context.Context, config AddressModel, headers, queryStrings *map[string]string, diags *diag.Diagnostics) addressSecretHashes {
+	var hashes addressSecretHashes
+	hashes.Headers = mergeSecretMap(ctx, config.SecretHeaders, headers, diags)
+	hashes.QueryStrings = mergeSecretMap(ctx, config.SecretQueryStrings, queryStrings, diags)
+	return hashes
+}
+
+func mergeSecretMap(ctx context.Context, secrets types.Map, target *map[string]string, diags *diag.Diagnostics) map[string]string {
+	if secrets.IsNull() || secrets.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]string)
+	diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+	if len(values) == 0 {
+		return nil
+	}
+
+	if *target == nil {
+		*target = make(map[string]string, len(values))
+	}
+
+	hashes := make(map[string]string, len(values))
+	for name, value := range values {
+		(*target)[name] = value
+		hashes[name] = privatestate.HashSecret(value)
+	}
+	return hashes
+}
+
+func getAddressSecretHashes(ctx context.Context, private privatestate.Store, diags *diag.Diagnostics) map[string]addressSecretHashes {
+	hashes := make(map[string]addressSecretHashes)
+	if !privatestate.GetJSON(ctx, private, privateKeyAddressSecrets, &hashes, diags) {
+		return make(map[string]addressSecretHashes)
+	}
+	return hashes
+}
+
+func setAddressSecretHashes(ctx context.Context, private privatestate.Store, hashes map[string]addressSecretHashes, diags *diag.Diagnostics) {
+	privatestate.SetJSON(ctx, private, privateKeyAddressSecrets, hashes, diags)
+}
+
+// configAddressMap holds the configured address blocks by identity. The
+// write-only secrets are only in config, and the plan may order its
+// addresses differently, so they are matched by identity, never by index.
+type configAddressMap map[string]AddressModel
+
+func configAddressesByIdentity(addresses []AddressModel) configAddressMap {
+	m := make(configAddressMap, len(addresses))
+	for _, addr := range addresses {
+		if identity, known := addressIdentity(addr); known {
+			m[identity] = addr
+		}
+	}
+	return m
+}
+
+// lookup returns the identity of a planned address and its configured
+// block.
+func (m configAddressMap) lookup(planAddr AddressModel) (string, AddressModel, bool) {
+	identity, known := addressIdentity(planAddr)
+	if !known {
+		return "", AddressModel{}, false
+	}
+	addr, ok := m[identity]
+	return identity, addr, ok
+}
+
+// resolveAddressSecretHashes keys the hashes collected while building a
+// request, indexed by address identity, by the address IDs the API returned.
+func resolveAddressSecretHashes(addresses []AddressModel, pending map[string]addressSecretHashes) map[string]addressSecretHashes {
+	resolved := make(map[string]addressSecretHashes)
+	for _, addr := range addresses {
+		identity, known := addressIdentity(addr)
+		if !known {
+			continue
+		}
+		h, ok := pending[identity]
+		if ok && !h.empty() {
+			resolved[addr.ID.ValueString()] = h
+		}
+	}
+	return resolved
+}
+
+// applyAddressSecretState removes secret header and query string names from
+// the address models so they never reach state, and clears secrets_version
+// on any address whose echoed secrets no longer match the stored hashes.
+func applyAddressSecretState(ctx context.Context, apiAddresses map[string]client.ContactAddress, hashes map[string]addressSecretHashes, addresses []AddressModel) {
+	for i := range addresses {
+		id := addresses[i].ID.ValueString()
+		h, ok := hashes[id]
+		if !ok {
+			continue
+		}
+		apiAddr := apiAddresses[id]
+
+		addresses[i].Headers = visibleSecretMap(ctx, apiAddr.Headers, h.Headers)
+		addresses[i].QueryStrings = visibleSecretMap(ctx, apiAddr.QueryStrings, h.QueryStrings)
+
+		if secretMapDrifted(apiAddr.Headers, h.Headers) || secretMapDrifted(apiAddr.QueryStrings, h.QueryStrings) {
+			tflog.Info(ctx, "Address secrets changed outside Terraform", map[string]interface{}{
+				"address_id": id,
+			})
+			addresses[i].SecretsVersion = types.Int64Null()
+		}
+	}
+}
+
+func visibleSecretMap(ctx context.Context, values, secrets map[string]string) types.Map {
+	visible := make(map[string]string)
+	for name, value := range values {
+		if _, secret := secrets[name]; !secret {
+			visible[name] = value
+		}
+	}
+	if len(visible) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	m, _ := types.MapValueFrom(ctx, types.StringType, visible)
+	return m
+}
+
+// secretMapDrifted reports whether any secret echoed by the API differs from
+// its stored hash. Maps the API does not echo cannot be compared.
+func secretMapDrifted(values, hashes map[string]string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for name, hash := range hashes {
+		if value, ok := values[name]; !ok || privatestate.HashSecret(value) != hash {
+			return true
+		}
+	}
+	return false
+}
+
+// secretsVersionValidator requires secret_headers or secret_querystrings
+// next to secrets_version, which only versions them. The framework's
+// AlsoRequires would demand both.
+type secretsVersionValidator struct{}
+
+var _ validator.Int64 = secretsVersionValidator{}
+
+func (v secretsVersionValidator) Description(ctx context.Context) string {
+	return "secret_headers or secret_querystrings must also be set"
+}
+
+func (v secretsVersionValidator) MarkdownDescription(ctx context.Context) string {
+	return "`secret_headers` or `secret_querystrings` must also be set"
+}
+
+func (v secretsVersionValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, name := range []string{"secret_headers", "secret_querystrings"} {
+		var secrets types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(name), &secrets)...)
+		if !secrets.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Combination",
+		"secrets_version versions secret_headers and secret_querystrings, so at least one of them must be set.",
+	)
+}
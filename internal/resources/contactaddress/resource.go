@@ -0,0 +1,449 @@
+package contactaddress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/client"
+	"github.com/nodeping/terraform-provider-nodeping/internal/providerdata"
+	"github.com/nodeping/terraform-provider-nodeping/internal/resources/contact"
+)
+
+var (
+	_ resource.Resource                = &ContactAddressResource{}
+	_ resource.ResourceWithConfigure   = &ContactAddressResource{}
+	_ resource.ResourceWithImportState = &ContactAddressResource{}
+)
+
+type ContactAddressResource struct {
+	client *client.Client
+}
+
+func NewContactAddressResource() resource.Resource {
+	return &ContactAddressResource{}
+}
+
+func (r *ContactAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact_address"
+}
+
+func (r *ContactAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ContactAddressSchema()
+}
+
+func (r *ContactAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+}
+
+func (r *ContactAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContactAddressResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contactID := plan.ContactID.ValueString()
+	tflog.Debug(ctx, "Creating contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"type":       plan.Type.ValueString(),
+	})
+
+	newAddr := buildNewAddress(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lock only covers this provider process; see Client.Lock.
+	unlock := r.client.Lock("contact", contactID)
+	defer unlock()
+
+	existing, err := r.client.GetContact(ctx, contactID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Contact Address",
+			"Could not read contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	contact, err := r.client.UpdateContact(ctx, contactID, client.ContactUpdateRequest{
+		NewAddresses: []client.NewAddress{newAddr},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Contact Address",
+			"Could not add address to contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	id := findNewAddressID(existing.Addresses, contact.Addresses, newAddr.Type, newAddr.Address)
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Error Creating Contact Address",
+			"NodePing accepted the address but did not return it on contact ID "+contactID+".",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	mapAddressToModel(ctx, contact.Addresses[id], &plan)
+
+	r.client.InvalidateNotificationTargets()
+
+	tflog.Debug(ctx, "Created contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContactAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContactAddressResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contactID := state.ContactID.ValueString()
+	id := state.ID.ValueString()
+	tflog.Debug(ctx, "Reading contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	contact, err := r.client.GetContact(ctx, contactID)
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			tflog.Debug(ctx, "Contact not found, removing address from state", map[string]interface{}{
+				"contact_id": contactID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Contact Address",
+			"Could not read contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	addr, ok := contact.Addresses[id]
+	if !ok {
+		tflog.Debug(ctx, "Contact address not found, removing from state", map[string]interface{}{
+			"contact_id": contactID,
+			"id":         id,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapAddressToModel(ctx, addr, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ContactAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContactAddressResourceModel
+	var state ContactAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contactID := state.ContactID.ValueString()
+	id := state.ID.ValueString()
+	tflog.Debug(ctx, "Updating contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	addrUpdate := buildContactAddress(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := r.client.Lock("contact", contactID)
+	defer unlock()
+
+	existing, err := r.client.GetContact(ctx, contactID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Contact Address",
+			"Could not read contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+	if _, ok := existing.Addresses[id]; !ok {
+		resp.Diagnostics.AddError(
+			"Error Updating Contact Address",
+			"Address ID "+id+" no longer exists on contact ID "+contactID+".",
+		)
+		return
+	}
+
+	// NodePing removes any existing address missing from the map, so every
+	// sibling address is sent back exactly as it was read.
+	addresses := make(map[string]client.ContactAddress, len(existing.Addresses))
+	for addrID, addr := range existing.Addresses {
+		addresses[addrID] = addr
+	}
+	addresses[id] = addrUpdate
+
+	contact, err := r.client.UpdateContact(ctx, contactID, client.ContactUpdateRequest{
+		Addresses: addresses,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Contact Address",
+			"Could not update address ID "+id+" on contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	if addr, ok := contact.Addresses[id]; ok {
+		mapAddressToModel(ctx, addr, &plan)
+	}
+
+	r.client.InvalidateNotificationTargets()
+
+	tflog.Debug(ctx, "Updated contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContactAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContactAddressResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contactID := state.ContactID.ValueString()
+	id := state.ID.ValueString()
+	tflog.Debug(ctx, "Deleting contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	unlock := r.client.Lock("contact", contactID)
+	defer unlock()
+
+	existing, err := r.client.GetContact(ctx, contactID)
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Contact Address",
+			"Could not read contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+	if _, ok := existing.Addresses[id]; !ok {
+		return
+	}
+
+	addresses := make(map[string]client.ContactAddress, len(existing.Addresses))
+	for addrID, addr := range existing.Addresses {
+		if addrID != id {
+			addresses[addrID] = addr
+		}
+	}
+
+	_, err = r.client.UpdateContact(ctx, contactID, client.ContactUpdateRequest{
+		Addresses: addresses,
+	})
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Contact Address",
+			"Could not remove address ID "+id+" from contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	r.client.InvalidateNotificationTargets()
+
+	tflog.Debug(ctx, "Deleted contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+}
+
+func (r *ContactAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'contact_id/address_id', got: %s", req.ID),
+		)
+		return
+	}
+	contactID, id := idParts[0], idParts[1]
+
+	tflog.Debug(ctx, "Importing contact address", map[string]interface{}{
+		"contact_id": contactID,
+		"id":         id,
+	})
+
+	contact, err := r.client.GetContact(ctx, contactID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Contact Address",
+			"Could not read contact ID "+contactID+": "+err.Error(),
+		)
+		return
+	}
+
+	addr, ok := contact.Addresses[id]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error Importing Contact Address",
+			"Address ID "+id+" was not found on contact ID "+contactID+".",
+		)
+		return
+	}
+
+	state := ContactAddressResourceModel{
+		ID:        types.StringValue(id),
+		ContactID: types.StringValue(contactID),
+	}
+	mapAddressToModel(ctx, addr, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findNewAddressID returns the ID of the address that appeared on the contact
+// between before and after with the given type and address.
+func findNewAddressID(before, after map[string]client.ContactAddress, addressType, address string) string {
+	candidates := make([]string, 0, 1)
+	for id, addr := range after {
+		if _, existed := before[id]; existed {
+			continue
+		}
+		if addr.Type == addressType && addr.Address == address {
+			candidates = append(candidates, id)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Strings(candidates)
+	return candidates[0]
+}
+
+func buildNewAddress(ctx context.Context, plan *ContactAddressResourceModel, diags *diag.Diagnostics) client.NewAddress {
+	newAddr := client.NewAddress{
+		Address:       plan.Address.ValueString(),
+		Type:          plan.Type.ValueString(),
+		SuppressUp:    plan.SuppressUp.ValueBool(),
+		SuppressDown:  plan.SuppressDown.ValueBool(),
+		SuppressFirst: plan.SuppressFirst.ValueBool(),
+		SuppressDiag:  plan.SuppressDiag.ValueBool(),
+		SuppressAll:   plan.SuppressAll.ValueBool(),
+		Mute:          plan.Mute.ValueBool(),
+	}
+
+	if !plan.Action.IsNull() {
+		newAddr.Action = plan.Action.ValueString()
+	}
+	if !plan.Data.IsNull() {
+		newAddr.Data = plan.Data.ValueString()
+	}
+	if !plan.Priority.IsNull() {
+		priority := int(plan.Priority.ValueInt64())
+		newAddr.Priority = &priority
+	}
+	newAddr.Headers = stringMap(ctx, plan.Headers, diags)
+	newAddr.QueryStrings = stringMap(ctx, plan.QueryStrings, diags)
+
+	return newAddr
+}
+
+func buildContactAddress(ctx context.Context, plan *ContactAddressResourceModel, diags *diag.Diagnostics) client.ContactAddress {
+	addr := client.ContactAddress{
+		Address:       plan.Address.ValueString(),
+		Type:          plan.Type.ValueString(),
+		SuppressUp:    plan.SuppressUp.ValueBool(),
+		SuppressDown:  plan.SuppressDown.ValueBool(),
+		SuppressFirst: plan.SuppressFirst.ValueBool(),
+		SuppressDiag:  plan.SuppressDiag.ValueBool(),
+		SuppressAll:   plan.SuppressAll.ValueBool(),
+	}
+
+	if plan.Mute.ValueBool() {
+		addr.Mute = []byte("true")
+	}
+	if !plan.Action.IsNull() {
+		addr.Action = plan.Action.ValueString()
+	}
+	if !plan.Data.IsNull() {
+		addr.Data = plan.Data.ValueString()
+	}
+	if !plan.Priority.IsNull() {
+		priority := int(plan.Priority.ValueInt64())
+		addr.Priority = &priority
+	}
+	addr.Headers = stringMap(ctx, plan.Headers, diags)
+	addr.QueryStrings = stringMap(ctx, plan.QueryStrings, diags)
+
+	return addr
+}
+
+func stringMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	values := make(map[string]string)
+	diags.Append(m.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// mapAddressToModel copies an API address onto the model, leaving id and
+// contact_id untouched.
+func mapAddressToModel(ctx context.Context, addr client.ContactAddress, model *ContactAddressResourceModel) {
+	m := contact.AddressModelFromAPI(ctx, model.ID.ValueString(), addr)
+	model.Type = m.Type
+	model.Address = m.Address
+	model.SuppressUp = m.SuppressUp
+	model.SuppressDown = m.SuppressDown
+	model.SuppressFirst = m.SuppressFirst
+	model.SuppressDiag = m.SuppressDiag
+	model.SuppressAll = m.SuppressAll
+	model.Mute = m.Mute
+	model.Action = m.Action
+	model.Headers = m.Headers
+	model.QueryStrings = m.QueryStrings
+	model.Data = m.Data
+	model.Priority = m.Priority
+}
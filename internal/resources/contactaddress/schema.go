@@ -0,0 +1,166 @@
+package contactaddress
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nodeping/terraform-provider-nodeping/internal/planmodifiers"
+)
+
+type ContactAddressResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ContactID     types.String `tfsdk:"contact_id"`
+	Type          types.String `tfsdk:"type"`
+	Address       types.String `tfsdk:"address"`
+	SuppressUp    types.Bool   `tfsdk:"suppress_up"`
+	SuppressDown  types.Bool   `tfsdk:"suppress_down"`
+	SuppressFirst types.Bool   `tfsdk:"suppress_first"`
+	SuppressDiag  types.Bool   `tfsdk:"suppress_diag"`
+	SuppressAll   types.Bool   `tfsdk:"suppress_all"`
+	Mute          types.Bool   `tfsdk:"mute"`
+	Action        types.String `tfsdk:"action"`
+	Headers       types.Map    `tfsdk:"headers"`
+	QueryStrings  types.Map    `tfsdk:"querystrings"`
+	Data          types.String `tfsdk:"data"`
+	Priority      types.Int64  `tfsdk:"priority"`
+}
+
+func ContactAddressSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a single address on an existing NodePing contact.",
+		MarkdownDescription: `
+Manages a single address on an existing NodePing contact.
+
+Use this resource when different teams own different addresses of a shared contact. Each address is added, updated and removed on its own; sibling addresses are left untouched.
+
+It can be combined with inline ` + "`address`" + ` blocks on ` + "`nodeping_contact`" + ` when the contact sets ` + "`manage_addresses = false`" + `, so they only manage the addresses they declare. Do not declare the same address in both places.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "nodeping_contact" "oncall" {
+  name             = "On-Call"
+  manage_addresses = false
+}
+
+resource "nodeping_contact_address" "noc_sms" {
+  contact_id = nodeping_contact.oncall.id
+  type       = "sms"
+  address    = "+15551234567"
+}
+
+resource "nodeping_contact_address" "app_webhook" {
+  contact_id = nodeping_contact.oncall.id
+  type       = "webhook"
+  address    = "https://hooks.example.com/nodeping"
+  action     = "post"
+}
+` + "```" + `
+
+## Import
+
+Contact addresses can be imported using ` + "`contact_id/address_id`" + `:
+
+` + "```shell" + `
+terraform import nodeping_contact_address.example 201205050153W2Q4C-BKPGH/K5SP9CQP
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the address.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"contact_id": schema.StringAttribute{
+				Description: "The ID of the contact that owns this address.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of address. Valid values: 'email', 'sms', 'webhook', 'slack', 'hipchat', 'pushover', 'pagerduty', 'voice'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("email", "sms", "webhook", "slack", "hipchat", "pushover", "pagerduty", "voice"),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The address value (email, phone number, webhook URL, etc.).",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"suppress_up": schema.BoolAttribute{
+				Description: "Suppress 'up' notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"suppress_down": schema.BoolAttribute{
+				Description: "Suppress 'down' notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"suppress_first": schema.BoolAttribute{
+				Description: "Suppress 'first result' notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"suppress_diag": schema.BoolAttribute{
+				Description: "Suppress diagnostic notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"suppress_all": schema.BoolAttribute{
+				Description: "Suppress all notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"mute": schema.BoolAttribute{
+				Description: "Mute all notifications to this address.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"action": schema.StringAttribute{
+				Description: "HTTP method for webhook addresses. Valid values: 'get', 'put', 'post', 'head', 'delete'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("get", "put", "post", "head", "delete"),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "HTTP headers for webhook addresses.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"querystrings": schema.MapAttribute{
+				Description: "Query string parameters for webhook addresses.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"data": schema.StringAttribute{
+				Description: "Request body for webhook addresses (POST/PUT).",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.JSONSemanticEqual(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority for Pushover addresses. Valid values: -2 to 2.",
+				Optional:    true,
+			},
+		},
+	}
+}